@@ -0,0 +1,240 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/go-kratos/kit/containers/lists"
+)
+
+// arcList is a recency-ordered list of entries indexed by key, most recent at the front.
+type arcList[K comparable, V any] struct {
+	items map[K]*lists.Element[entry[K, V]]
+	order *lists.List[entry[K, V]]
+}
+
+func newARCList[K comparable, V any]() arcList[K, V] {
+	return arcList[K, V]{
+		items: make(map[K]*lists.Element[entry[K, V]]),
+		order: lists.New[entry[K, V]](),
+	}
+}
+
+func (l *arcList[K, V]) len() int {
+	return len(l.items)
+}
+
+func (l *arcList[K, V]) get(key K) (*lists.Element[entry[K, V]], bool) {
+	e, ok := l.items[key]
+	return e, ok
+}
+
+func (l *arcList[K, V]) pushFront(key K, value V) {
+	l.items[key] = l.order.PushFront(entry[K, V]{key: key, value: value})
+}
+
+func (l *arcList[K, V]) remove(key K) (entry[K, V], bool) {
+	e, ok := l.items[key]
+	if !ok {
+		return entry[K, V]{}, false
+	}
+	delete(l.items, key)
+	return l.order.Remove(e), true
+}
+
+func (l *arcList[K, V]) removeBack() entry[K, V] {
+	e := l.order.Remove(l.order.Back())
+	delete(l.items, e.key)
+	return e
+}
+
+func (l *arcList[K, V]) clear() {
+	clear(l.items)
+	l.order.Clear()
+}
+
+// ARCCache is a thread-safe fixed-capacity cache using the adaptive replacement policy.
+// It balances a recency list (t1) and a frequency list (t2), and keeps ghost lists of
+// recently evicted keys (b1, b2) to learn which of the two deserves more room, so it
+// adapts between scan-like and hot-set access patterns.
+type ARCCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	// p is the target size of t1.
+	p      int
+	t1, t2 arcList[K, V]
+	b1, b2 arcList[K, V]
+	stats  Stats
+	opts   options[K, V]
+}
+
+// NewARC creates an ARCCache holding at most capacity entries.
+// A capacity less than 1 is treated as 1.
+func NewARC[K comparable, V any](capacity int, opts ...Option[K, V]) *ARCCache[K, V] {
+	return &ARCCache[K, V]{
+		capacity: max(capacity, 1),
+		t1:       newARCList[K, V](),
+		t2:       newARCList[K, V](),
+		b1:       newARCList[K, V](),
+		b2:       newARCList[K, V](),
+		opts:     applyOptions(opts),
+	}
+}
+
+// Get returns the value for key and promotes it to the frequency list.
+func (c *ARCCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.t1.remove(key); ok {
+		c.stats.Hits++
+		c.t2.pushFront(e.key, e.value)
+		return e.value, true
+	}
+	if e, ok := c.t2.get(key); ok {
+		c.stats.Hits++
+		c.t2.order.MoveToFront(e)
+		return e.Value.value, true
+	}
+	c.stats.Misses++
+	var zero V
+	return zero, false
+}
+
+// Peek returns the value for key without updating its position or the stats.
+func (c *ARCCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.t1.get(key); ok {
+		return e.Value.value, true
+	}
+	if e, ok := c.t2.get(key); ok {
+		return e.Value.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the cache without updating its position.
+func (c *ARCCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok1 := c.t1.get(key)
+	_, ok2 := c.t2.get(key)
+	return ok1 || ok2
+}
+
+// Put sets the value for key, evicting an entry if the cache is full.
+// It reports whether an entry was evicted.
+func (c *ARCCache[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	evicted := c.put(key, value)
+	c.mu.Unlock()
+	c.opts.notifyEvicted(evicted)
+	return len(evicted) > 0
+}
+
+func (c *ARCCache[K, V]) put(key K, value V) []entry[K, V] {
+	if _, ok := c.t1.remove(key); ok {
+		c.t2.pushFront(key, value)
+		return nil
+	}
+	if e, ok := c.t2.get(key); ok {
+		e.Value.value = value
+		c.t2.order.MoveToFront(e)
+		return nil
+	}
+
+	var evicted []entry[K, V]
+	if _, ok := c.b1.get(key); ok {
+		// A recently evicted key came back: give recency more room.
+		c.p = min(c.capacity, c.p+max(c.b2.len()/c.b1.len(), 1))
+		evicted = c.replace(false)
+		c.b1.remove(key)
+		c.t2.pushFront(key, value)
+		return evicted
+	}
+	if _, ok := c.b2.get(key); ok {
+		// A key evicted from the frequency list came back: give frequency more room.
+		c.p = max(0, c.p-max(c.b1.len()/c.b2.len(), 1))
+		evicted = c.replace(true)
+		c.b2.remove(key)
+		c.t2.pushFront(key, value)
+		return evicted
+	}
+
+	if c.t1.len()+c.b1.len() >= c.capacity {
+		if c.t1.len() < c.capacity {
+			c.b1.removeBack()
+			evicted = c.replace(false)
+		} else {
+			c.stats.Evictions++
+			evicted = append(evicted, c.t1.removeBack())
+		}
+	} else if total := c.t1.len() + c.t2.len() + c.b1.len() + c.b2.len(); total >= c.capacity {
+		if total >= 2*c.capacity {
+			c.b2.removeBack()
+		}
+		evicted = c.replace(false)
+	}
+	c.t1.pushFront(key, value)
+	return evicted
+}
+
+// replace evicts an entry from t1 or t2 into its ghost list if the cache is full.
+// inB2 reports whether the key being added was found in b2.
+func (c *ARCCache[K, V]) replace(inB2 bool) []entry[K, V] {
+	if c.t1.len()+c.t2.len() < c.capacity {
+		return nil
+	}
+	var e entry[K, V]
+	if t1 := c.t1.len(); t1 > 0 && (t1 > c.p || (inB2 && t1 == c.p) || c.t2.len() == 0) {
+		e = c.t1.removeBack()
+		c.b1.pushFront(e.key, *new(V))
+	} else {
+		e = c.t2.removeBack()
+		c.b2.pushFront(e.key, *new(V))
+	}
+	c.stats.Evictions++
+	return []entry[K, V]{e}
+}
+
+// Remove removes key from the cache and reports whether it was present.
+func (c *ARCCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.b1.remove(key)
+	c.b2.remove(key)
+	_, ok1 := c.t1.remove(key)
+	_, ok2 := c.t2.remove(key)
+	return ok1 || ok2
+}
+
+// Len returns the number of entries in the cache.
+func (c *ARCCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t1.len() + c.t2.len()
+}
+
+// Cap returns the capacity of the cache.
+func (c *ARCCache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Clear removes all entries and forgets the learned balance,
+// without invoking the eviction callback.
+func (c *ARCCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t1.clear()
+	c.t2.clear()
+	c.b1.clear()
+	c.b2.clear()
+	c.p = 0
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *ARCCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
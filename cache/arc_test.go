@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestARCCacheKeepsHotSetThroughScan(t *testing.T) {
+	c := NewARC[int, int](10)
+	for round := 0; round < 3; round++ {
+		for k := range 5 {
+			c.Put(k, k)
+			c.Get(k)
+		}
+	}
+	// A long scan of one-off keys should not flush the frequently used ones.
+	for k := 100; k < 200; k++ {
+		c.Put(k, k)
+	}
+	for k := range 5 {
+		if !c.Contains(k) {
+			t.Fatalf("expected hot key %d to survive the scan", k)
+		}
+	}
+}
+
+func TestARCCacheBounds(t *testing.T) {
+	c := NewARC[int, int](16)
+	r := rand.New(rand.NewSource(1))
+	for range 10000 {
+		k := r.Intn(64)
+		if _, ok := c.Get(k); !ok {
+			c.Put(k, k)
+		}
+		if r.Intn(50) == 0 {
+			c.Remove(r.Intn(64))
+		}
+		if c.Len() > 16 {
+			t.Fatalf("cache grew beyond its capacity: %d", c.Len())
+		}
+		if ghosts := c.b1.len() + c.b2.len(); ghosts > 2*16-c.Len() {
+			t.Fatalf("ghost lists grew beyond capacity: %d", ghosts)
+		}
+		if c.p < 0 || c.p > 16 {
+			t.Fatalf("target size out of range: %d", c.p)
+		}
+	}
+}
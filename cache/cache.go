@@ -0,0 +1,29 @@
+package cache
+
+// Cache is the common interface of the capacity-bounded caches in this package,
+// so the eviction policy can be swapped without changing callers.
+type Cache[K comparable, V any] interface {
+	// Get returns the value for key and records the access.
+	Get(key K) (V, bool)
+	// Peek returns the value for key without recording the access.
+	Peek(key K) (V, bool)
+	// Put sets the value for key and reports whether an entry was evicted.
+	Put(key K, value V) bool
+	// Remove removes key and reports whether it was present.
+	Remove(key K) bool
+	// Contains reports whether key is present without recording the access.
+	Contains(key K) bool
+	// Len returns the number of entries.
+	Len() int
+	// Clear removes all entries.
+	Clear()
+	// Stats returns a snapshot of the counters.
+	Stats() Stats
+}
+
+var (
+	_ Cache[string, any] = (*LRUCache[string, any])(nil)
+	_ Cache[string, any] = (*LFUCache[string, any])(nil)
+	_ Cache[string, any] = (*ARCCache[string, any])(nil)
+	_ Cache[string, any] = (*TTLCache[string, any])(nil)
+)
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"runtime"
+	"sync"
+	"weak"
+)
+
+// Interner is a thread-safe canonicalization cache: Intern returns one shared
+// instance per distinct value, so repeated strings or structs kept in long-lived
+// maps share memory. Canonical instances are held weakly and released by the
+// garbage collector once nothing references them, except for the most recently
+// interned values up to the size limit, which are kept alive.
+type Interner[T comparable] struct {
+	mu     sync.Mutex
+	values map[T]weak.Pointer[T]
+	recent *LRUCache[T, *T]
+	stats  Stats
+}
+
+// NewInterner creates an Interner that keeps at most size recently interned values
+// alive. A size less than 1 holds every value weakly.
+func NewInterner[T comparable](size int) *Interner[T] {
+	in := &Interner[T]{values: make(map[T]weak.Pointer[T])}
+	if size > 0 {
+		in.recent = NewLRU[T, *T](size)
+	}
+	return in
+}
+
+// Intern returns the canonical instance equal to value.
+func (in *Interner[T]) Intern(value T) T {
+	return *in.Pointer(value)
+}
+
+// Pointer returns a pointer to the canonical instance equal to value.
+// The instance stays canonical for as long as the pointer is reachable.
+func (in *Interner[T]) Pointer(value T) *T {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if wp, ok := in.values[value]; ok {
+		if p := wp.Value(); p != nil {
+			in.stats.Hits++
+			in.keep(value, p)
+			return p
+		}
+	}
+	in.stats.Misses++
+	p := new(T)
+	*p = value
+	in.values[value] = weak.Make(p)
+	runtime.AddCleanup(p, in.release, value)
+	in.keep(value, p)
+	return p
+}
+
+func (in *Interner[T]) keep(value T, p *T) {
+	if in.recent != nil {
+		in.recent.Put(value, p)
+	}
+}
+
+// release drops the entry for value once its canonical instance has been collected.
+func (in *Interner[T]) release(value T) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if wp, ok := in.values[value]; ok && wp.Value() == nil {
+		delete(in.values, value)
+		in.stats.Evictions++
+	}
+}
+
+// Len returns the number of canonical instances that are still alive.
+func (in *Interner[T]) Len() int {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	n := 0
+	for _, wp := range in.values {
+		if wp.Value() != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Clear forgets every canonical instance. Values interned afterwards get new instances.
+func (in *Interner[T]) Clear() {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	clear(in.values)
+	if in.recent != nil {
+		in.recent.Clear()
+	}
+}
+
+// Stats returns a snapshot of the counters. Evictions counts instances released
+// by the garbage collector.
+func (in *Interner[T]) Stats() Stats {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return in.stats
+}
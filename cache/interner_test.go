@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestInterner(t *testing.T) {
+	in := NewInterner[string](0)
+	a := in.Intern(strings.Repeat("x", 16))
+	b := in.Intern(strings.Repeat("x", 16))
+	if unsafe.StringData(a) != unsafe.StringData(b) {
+		t.Fatal("expected equal strings to share one instance")
+	}
+	if p, q := in.Pointer("y"), in.Pointer("y"); p != q {
+		t.Fatal("expected the same canonical pointer")
+	}
+	if s := in.Stats(); s.Hits != 2 || s.Misses != 2 {
+		t.Fatalf("unexpected stats: %+v", s)
+	}
+	runtime.KeepAlive(a)
+}
+
+func TestInternerReleasesUnreferenced(t *testing.T) {
+	// Strings rather than ints, so the instances are not batched by the tiny allocator.
+	in := NewInterner[string](1)
+	for i := range 10 {
+		in.Pointer(strconv.Itoa(i))
+	}
+	for range 5 {
+		runtime.GC()
+		if in.Len() == 1 {
+			break
+		}
+	}
+	if n := in.Len(); n != 1 {
+		t.Fatalf("expected only the size-limited value to stay alive, got %d", n)
+	}
+	if _, ok := in.recent.Peek("9"); !ok {
+		t.Fatal("expected the most recent value to be kept")
+	}
+}
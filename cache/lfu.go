@@ -0,0 +1,178 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/go-kratos/kit/containers/lists"
+)
+
+type lfuBucket[K comparable, V any] struct {
+	freq    int
+	entries *lists.List[*lfuEntry[K, V]]
+}
+
+type lfuEntry[K comparable, V any] struct {
+	entry[K, V]
+	bucket  *lists.Element[*lfuBucket[K, V]]
+	element *lists.Element[*lfuEntry[K, V]]
+}
+
+// LFUCache is a thread-safe fixed-capacity cache that evicts the least frequently used entry,
+// breaking ties by evicting the least recently used one.
+// Entries are grouped into per-frequency buckets kept in ascending order,
+// so every operation is O(1).
+type LFUCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[K]*lfuEntry[K, V]
+	buckets  *lists.List[*lfuBucket[K, V]]
+	stats    Stats
+	opts     options[K, V]
+}
+
+// NewLFU creates an LFUCache holding at most capacity entries.
+// A capacity less than 1 is treated as 1.
+func NewLFU[K comparable, V any](capacity int, opts ...Option[K, V]) *LFUCache[K, V] {
+	return &LFUCache[K, V]{
+		capacity: max(capacity, 1),
+		items:    make(map[K]*lfuEntry[K, V]),
+		buckets:  lists.New[*lfuBucket[K, V]](),
+		opts:     applyOptions(opts),
+	}
+}
+
+// unlink removes e from its bucket, dropping the bucket once it is empty.
+func (c *LFUCache[K, V]) unlink(e *lfuEntry[K, V]) {
+	b := e.bucket.Value
+	b.entries.Remove(e.element)
+	if b.entries.Len() == 0 {
+		c.buckets.Remove(e.bucket)
+	}
+}
+
+// touch moves e into the bucket for its next frequency.
+func (c *LFUCache[K, V]) touch(e *lfuEntry[K, V]) {
+	cur := e.bucket
+	freq := cur.Value.freq + 1
+	next := cur.Next()
+	if next == nil || next.Value.freq != freq {
+		next = c.buckets.InsertAfter(&lfuBucket[K, V]{freq: freq, entries: lists.New[*lfuEntry[K, V]]()}, cur)
+	}
+	c.unlink(e)
+	e.bucket = next
+	e.element = next.Value.entries.PushFront(e)
+}
+
+// Get returns the value for key and increments its use count.
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Peek returns the value for key without updating its use count or the stats.
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the cache without updating its use count.
+func (c *LFUCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.items[key]
+	return ok
+}
+
+// Put sets the value for key and increments its use count, evicting the least
+// frequently used entry if the cache is full. It reports whether an entry was evicted.
+func (c *LFUCache[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		c.touch(e)
+		c.mu.Unlock()
+		return false
+	}
+	var evicted []entry[K, V]
+	if len(c.items) >= c.capacity {
+		victim := c.buckets.Front().Value.entries.Back().Value
+		c.unlink(victim)
+		delete(c.items, victim.key)
+		c.stats.Evictions++
+		evicted = append(evicted, victim.entry)
+	}
+	first := c.buckets.Front()
+	if first == nil || first.Value.freq != 1 {
+		first = c.buckets.PushFront(&lfuBucket[K, V]{freq: 1, entries: lists.New[*lfuEntry[K, V]]()})
+	}
+	e := &lfuEntry[K, V]{entry: entry[K, V]{key: key, value: value}, bucket: first}
+	e.element = first.Value.entries.PushFront(e)
+	c.items[key] = e
+	c.mu.Unlock()
+	c.opts.notifyEvicted(evicted)
+	return len(evicted) > 0
+}
+
+// Remove removes key from the cache and reports whether it was present.
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.unlink(e)
+	delete(c.items, key)
+	return true
+}
+
+// Frequency returns the use count of key, or 0 if it is not in the cache.
+func (c *LFUCache[K, V]) Frequency(key K) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		return e.bucket.Value.freq
+	}
+	return 0
+}
+
+// Len returns the number of entries in the cache.
+func (c *LFUCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Cap returns the capacity of the cache.
+func (c *LFUCache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Clear removes all entries from the cache without invoking the eviction callback.
+func (c *LFUCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.items)
+	c.buckets.Clear()
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *LFUCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
@@ -0,0 +1,29 @@
+package cache
+
+import "testing"
+
+func TestLFUCacheEvictsLeastFrequentlyUsed(t *testing.T) {
+	var evicted []string
+	var c Cache[string, int] = NewLFU(2, WithOnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+	}))
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("c", 3) // b has fewer uses than a
+
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Fatalf("expected b to be evicted, got %v", evicted)
+	}
+
+	c.Put("d", 4) // c has been used once, a three times
+	if c.Contains("c") || !c.Contains("a") || !c.Contains("d") {
+		t.Fatalf("expected a and d to remain")
+	}
+	if f := c.(*LFUCache[string, int]).Frequency("a"); f != 3 {
+		t.Fatalf("expected a to be used 3 times, got %d", f)
+	}
+}
@@ -0,0 +1,166 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kit/singleflight"
+)
+
+type loadedEntry[V any] struct {
+	value    V
+	loadedAt time.Time
+}
+
+// LoadingCache is a thread-safe LRU cache that fills misses with a loader function.
+// Concurrent misses for the same key share a single load, which protects the
+// backing store from cache stampedes. Failed loads are not cached.
+type LoadingCache[K comparable, V any] struct {
+	entries *LRUCache[K, loadedEntry[V]]
+	loader  func(ctx context.Context, key K) (V, error)
+	flight  singleflight.Singleflight[K, V]
+	opts    options[K, V]
+	now     func() time.Time
+
+	mu      sync.Mutex
+	seq     uint64
+	loads   map[K]uint64 // key -> sequence number of its running load
+	evicted []entry[K, V]
+}
+
+// NewLoading creates a LoadingCache holding at most capacity entries, loading
+// missing keys with loader. A capacity less than 1 is treated as 1.
+func NewLoading[K comparable, V any](capacity int, loader func(ctx context.Context, key K) (V, error), opts ...Option[K, V]) *LoadingCache[K, V] {
+	c := &LoadingCache[K, V]{
+		loader: loader,
+		opts:   applyOptions(opts),
+	}
+	c.now = c.opts.now
+	var lruOpts []Option[K, loadedEntry[V]]
+	if c.opts.onEvict != nil {
+		// Entries are only stored with c.mu held, so evictions are queued and
+		// reported once it is released; see store.
+		lruOpts = append(lruOpts, WithOnEvict(func(key K, e loadedEntry[V]) {
+			c.evicted = append(c.evicted, entry[K, V]{key: key, value: e.value})
+		}))
+	}
+	c.entries = NewLRU(capacity, lruOpts...)
+	return c
+}
+
+// Get returns the cached value for key, loading it on a miss. Callers that miss
+// while a load for key is already running wait for its result instead of starting
+// another one; a caller stops waiting when its own ctx is done, but the load keeps
+// running without the caller's cancellation so the other waiters still get its result.
+// With WithRefreshAfter, a stale hit is returned immediately and reloaded in the background.
+func (c *LoadingCache[K, V]) Get(ctx context.Context, key K) (V, error) {
+	if e, ok := c.entries.Get(key); ok {
+		if c.opts.refreshAfter > 0 && c.now().Sub(e.loadedAt) >= c.opts.refreshAfter {
+			c.load(ctx, key)
+		}
+		return e.value, nil
+	}
+	select {
+	case r := <-c.load(ctx, key):
+		return r.Value, r.Err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// load starts loading key unless a load is already running, and returns a channel
+// receiving the result. The loader sees the values of ctx but not its cancellation,
+// since the load is shared with callers that have their own deadlines.
+// A result is only cached if key was not written or removed while it was loading.
+func (c *LoadingCache[K, V]) load(ctx context.Context, key K) <-chan singleflight.Result[V] {
+	ctx = context.WithoutCancel(ctx)
+	return c.flight.DoChan(key, func() (V, error) {
+		c.mu.Lock()
+		if c.loads == nil {
+			c.loads = make(map[K]uint64)
+		}
+		c.seq++
+		seq := c.seq
+		c.loads[key] = seq
+		c.mu.Unlock()
+
+		value, err := c.loader(ctx, key)
+
+		c.mu.Lock()
+		if cur, ok := c.loads[key]; !ok || cur != seq {
+			c.mu.Unlock()
+			return value, err
+		}
+		delete(c.loads, key)
+		if err != nil {
+			c.mu.Unlock()
+			return value, err
+		}
+		c.store(key, value)
+		return value, err
+	})
+}
+
+// store caches value for key, then releases c.mu and reports any evicted entries,
+// so the eviction callback may call back into the cache. It must be called with c.mu held.
+func (c *LoadingCache[K, V]) store(key K, value V) bool {
+	evicted := c.entries.Put(key, loadedEntry[V]{value: value, loadedAt: c.now()})
+	pending := c.evicted
+	c.evicted = nil
+	c.mu.Unlock()
+	c.opts.notifyEvicted(pending)
+	return evicted
+}
+
+// invalidate stops a running load of key from caching its result, and lets the
+// next miss start a fresh load. It must be called with c.mu held.
+func (c *LoadingCache[K, V]) invalidate(key K) {
+	if _, ok := c.loads[key]; ok {
+		delete(c.loads, key)
+		c.flight.Forget(key)
+	}
+}
+
+// Peek returns the cached value for key without loading it or updating its recency.
+func (c *LoadingCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.entries.Peek(key)
+	return e.value, ok
+}
+
+// Put sets the value for key, bypassing the loader.
+// It reports whether an entry was evicted.
+func (c *LoadingCache[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	c.invalidate(key)
+	return c.store(key, value)
+}
+
+// Remove removes key from the cache and reports whether it was present.
+func (c *LoadingCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(key)
+	return c.entries.Remove(key)
+}
+
+// Len returns the number of entries in the cache.
+func (c *LoadingCache[K, V]) Len() int {
+	return c.entries.Len()
+}
+
+// Clear removes all entries from the cache without invoking the eviction callback.
+func (c *LoadingCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.loads {
+		c.invalidate(key)
+	}
+	c.entries.Clear()
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *LoadingCache[K, V]) Stats() Stats {
+	return c.entries.Stats()
+}
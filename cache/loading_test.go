@@ -0,0 +1,196 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoadingCacheLoadsOncePerKey(t *testing.T) {
+	var loads atomic.Int32
+	release := make(chan struct{})
+	c := NewLoading(8, func(_ context.Context, key string) (int, error) {
+		loads.Add(1)
+		<-release
+		return len(key), nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := c.Get(context.Background(), "abc"); err != nil || v != 3 {
+				t.Errorf("unexpected result %d, %v", v, err)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := loads.Load(); n != 1 {
+		t.Fatalf("expected a single load, got %d", n)
+	}
+	if v, ok := c.Peek("abc"); !ok || v != 3 {
+		t.Fatalf("expected loaded value to be cached, got %d (ok=%v)", v, ok)
+	}
+}
+
+func TestLoadingCacheCallerCancelDoesNotFailLoad(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	c := NewLoading(8, func(ctx context.Context, key string) (int, error) {
+		once.Do(func() { close(started) })
+		select {
+		case <-release:
+			return len(key), nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := c.Get(ctx, "abc")
+		first <- err
+	}()
+	<-started
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the first caller to be canceled, got %v", err)
+	}
+
+	second := make(chan int)
+	go func() {
+		v, err := c.Get(context.Background(), "abc")
+		if err != nil {
+			t.Error(err)
+		}
+		second <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	if v := <-second; v != 3 {
+		t.Fatalf("expected the shared load to succeed, got %d", v)
+	}
+	if v, ok := c.Peek("abc"); !ok || v != 3 {
+		t.Fatalf("expected the value to be cached, got %d (ok=%v)", v, ok)
+	}
+}
+
+func TestLoadingCacheErrorsAreNotCached(t *testing.T) {
+	errBoom := errors.New("boom")
+	fail := true
+	c := NewLoading(8, func(_ context.Context, key string) (string, error) {
+		if fail {
+			return "", errBoom
+		}
+		return key, nil
+	})
+
+	if _, err := c.Get(context.Background(), "k"); !errors.Is(err, errBoom) {
+		t.Fatalf("expected loader error, got %v", err)
+	}
+	fail = false
+	if v, err := c.Get(context.Background(), "k"); err != nil || v != "k" {
+		t.Fatalf("expected retry to load, got %q, %v", v, err)
+	}
+}
+
+func TestLoadingCacheRefreshAhead(t *testing.T) {
+	now := time.Unix(0, 0)
+	var version atomic.Int32
+	reloaded := make(chan struct{}, 1)
+	c := NewLoading(8, func(_ context.Context, _ string) (int32, error) {
+		v := version.Add(1)
+		if v > 1 {
+			reloaded <- struct{}{}
+		}
+		return v, nil
+	},
+		WithRefreshAfter[string, int32](time.Minute),
+		WithClock[string, int32](func() time.Time { return now }),
+	)
+
+	ctx := context.Background()
+	if v, _ := c.Get(ctx, "k"); v != 1 {
+		t.Fatalf("expected first load, got %d", v)
+	}
+	now = now.Add(2 * time.Minute)
+	if v, _ := c.Get(ctx, "k"); v != 1 {
+		t.Fatalf("expected stale value while refreshing, got %d", v)
+	}
+	<-reloaded
+	for {
+		if v, _ := c.Peek("k"); v == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestLoadingCacheRemoveDuringLoad(t *testing.T) {
+	var loads atomic.Int32
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	c := NewLoading(8, func(_ context.Context, key string) (int, error) {
+		n := loads.Add(1)
+		started <- struct{}{}
+		if n == 1 {
+			<-release
+		}
+		return int(n), nil
+	})
+
+	first := make(chan int)
+	go func() {
+		v, _ := c.Get(context.Background(), "k")
+		first <- v
+	}()
+	<-started
+	if c.Remove("k") {
+		t.Fatal("expected nothing to remove before the load finishes")
+	}
+	close(release)
+	if v := <-first; v != 1 {
+		t.Fatalf("expected the waiting caller to get the loaded value, got %d", v)
+	}
+	if _, ok := c.Peek("k"); ok {
+		t.Fatal("expected the removal to win over the in-flight load")
+	}
+
+	// The next miss starts a fresh load instead of joining the stale one.
+	if v, err := c.Get(context.Background(), "k"); err != nil || v != 2 {
+		t.Fatalf("expected a fresh load, got %d (err=%v)", v, err)
+	}
+	if v, ok := c.Peek("k"); !ok || v != 2 {
+		t.Fatalf("expected the fresh value to be cached, got %d (ok=%v)", v, ok)
+	}
+}
+
+func TestLoadingCacheOnEvictMayCallBack(t *testing.T) {
+	var c *LoadingCache[string, int]
+	var evicted []string
+	c = NewLoading(1, func(_ context.Context, key string) (int, error) {
+		return len(key), nil
+	}, WithOnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+		c.Remove(key)
+	}))
+	if _, err := c.Get(context.Background(), "a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(context.Background(), "bb"); err != nil {
+		t.Fatal(err)
+	}
+	c.Put("ccc", 3)
+	if len(evicted) != 2 || evicted[0] != "a" || evicted[1] != "bb" {
+		t.Fatalf("unexpected evictions: %v", evicted)
+	}
+}
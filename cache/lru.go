@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/go-kratos/kit/containers/lists"
+)
+
+// LRUCache is a thread-safe fixed-capacity cache that evicts the least recently used entry.
+type LRUCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[K]*lists.Element[entry[K, V]]
+	order    *lists.List[entry[K, V]]
+	stats    Stats
+	opts     options[K, V]
+}
+
+// NewLRU creates an LRUCache holding at most capacity entries.
+// A capacity less than 1 is treated as 1.
+func NewLRU[K comparable, V any](capacity int, opts ...Option[K, V]) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		capacity: max(capacity, 1),
+		items:    make(map[K]*lists.Element[entry[K, V]]),
+		order:    lists.New[entry[K, V]](),
+		opts:     applyOptions(opts),
+	}
+}
+
+// Get returns the value for key and marks it as most recently used.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(e)
+	return e.Value.value, true
+}
+
+// Peek returns the value for key without updating its recency or the stats.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		return e.Value.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the cache without updating its recency.
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.items[key]
+	return ok
+}
+
+// Put sets the value for key, marks it as most recently used and
+// evicts the least recently used entry if the cache is over capacity.
+// It reports whether an entry was evicted.
+func (c *LRUCache[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	if e, ok := c.items[key]; ok {
+		e.Value.value = value
+		c.order.MoveToFront(e)
+		c.mu.Unlock()
+		return false
+	}
+	c.items[key] = c.order.PushFront(entry[K, V]{key: key, value: value})
+	var evicted []entry[K, V]
+	if len(c.items) > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.key)
+		c.stats.Evictions++
+		evicted = append(evicted, oldest.Value)
+	}
+	c.mu.Unlock()
+	c.opts.notifyEvicted(evicted)
+	return len(evicted) > 0
+}
+
+// Remove removes key from the cache and reports whether it was present.
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.order.Remove(e)
+	delete(c.items, key)
+	return true
+}
+
+// Keys returns the keys in the cache, from most to least recently used.
+func (c *LRUCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keys := make([]K, 0, len(c.items))
+	for e := c.order.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value.key)
+	}
+	return keys
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Cap returns the capacity of the cache.
+func (c *LRUCache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Clear removes all entries from the cache without invoking the eviction callback.
+func (c *LRUCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.items)
+	c.order.Clear()
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *LRUCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
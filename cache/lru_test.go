@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	var evicted []string
+	c := NewLRU(2, WithOnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+	}))
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+
+	if !slices.Equal(evicted, []string{"b"}) {
+		t.Fatalf("expected b to be evicted, got %v", evicted)
+	}
+	if got := c.Keys(); !slices.Equal(got, []string{"c", "a"}) {
+		t.Fatalf("unexpected recency order: %v", got)
+	}
+
+	c.Peek("a")
+	c.Put("d", 4)
+	if c.Contains("a") {
+		t.Fatalf("expected Peek to not refresh a")
+	}
+
+	c.Get("missing")
+	if s := c.Stats(); s.Hits != 1 || s.Misses != 1 || s.Evictions != 2 {
+		t.Fatalf("unexpected stats: %+v", s)
+	}
+}
@@ -0,0 +1,118 @@
+package cache
+
+import "time"
+
+// Option is cache option.
+type Option[K comparable, V any] func(*options[K, V])
+
+type options[K comparable, V any] struct {
+	onEvict         func(key K, value V)
+	onExpire        func(key K, value V)
+	sliding         bool
+	cleanupInterval time.Duration
+	refreshAfter    time.Duration
+	now             func() time.Time
+}
+
+// WithOnEvict sets a callback invoked with every entry evicted to make room for new ones.
+// It is not invoked for entries removed explicitly. The callback runs after the cache
+// lock is released, so it may safely call back into the cache.
+func WithOnEvict[K comparable, V any](fn func(key K, value V)) Option[K, V] {
+	return func(o *options[K, V]) {
+		o.onEvict = fn
+	}
+}
+
+// WithOnExpire sets a callback invoked with every entry that is dropped because it expired.
+// It is only used by TTLCache, and runs after the cache lock is released.
+func WithOnExpire[K comparable, V any](fn func(key K, value V)) Option[K, V] {
+	return func(o *options[K, V]) {
+		o.onExpire = fn
+	}
+}
+
+// WithSliding makes every successful Get extend the entry's lifetime by its TTL.
+// It is only used by TTLCache.
+func WithSliding[K comparable, V any]() Option[K, V] {
+	return func(o *options[K, V]) {
+		o.sliding = true
+	}
+}
+
+// WithCleanupInterval overrides how often the background janitor drops expired entries.
+// It is only used by TTLCache.
+func WithCleanupInterval[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(o *options[K, V]) {
+		if d > 0 {
+			o.cleanupInterval = d
+		}
+	}
+}
+
+// WithRefreshAfter makes a Get that hits an entry loaded more than d ago return it
+// and reload it in the background. It is only used by LoadingCache.
+func WithRefreshAfter[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(o *options[K, V]) {
+		if d > 0 {
+			o.refreshAfter = d
+		}
+	}
+}
+
+// WithClock sets the function used to read the current time, which defaults to
+// time.Now. It is used by TTLCache and LoadingCache, mainly to control time in tests.
+func WithClock[K comparable, V any](now func() time.Time) Option[K, V] {
+	return func(o *options[K, V]) {
+		if now != nil {
+			o.now = now
+		}
+	}
+}
+
+func applyOptions[K comparable, V any](opts []Option[K, V]) options[K, V] {
+	o := options[K, V]{now: time.Now}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Stats holds cache hit, miss and eviction counters.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRate returns the ratio of hits to lookups, or 0 if there were no lookups.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// notifyEvicted invokes the eviction callback, if any, for each evicted entry.
+func (o *options[K, V]) notifyEvicted(evicted []entry[K, V]) {
+	notify(o.onEvict, evicted)
+}
+
+// notifyExpired invokes the expiry callback, if any, for each expired entry.
+func (o *options[K, V]) notifyExpired(expired []entry[K, V]) {
+	notify(o.onExpire, expired)
+}
+
+func notify[K comparable, V any](fn func(key K, value V), entries []entry[K, V]) {
+	if fn == nil {
+		return
+	}
+	for _, e := range entries {
+		fn(e.key, e.value)
+	}
+}
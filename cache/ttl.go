@@ -0,0 +1,217 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// NoExpiration marks an entry that never expires.
+const NoExpiration time.Duration = 0
+
+const defaultCleanupInterval = time.Minute
+
+type ttlEntry[V any] struct {
+	value     V
+	ttl       time.Duration
+	expiresAt time.Time
+}
+
+func (e *ttlEntry[V]) expired(now time.Time) bool {
+	return e.ttl > 0 && !now.Before(e.expiresAt)
+}
+
+// TTLCache is a thread-safe cache whose entries expire after a time-to-live.
+// Expired entries are never returned; they are dropped lazily on access and
+// periodically by a background janitor goroutine, which is stopped by Close.
+type TTLCache[K comparable, V any] struct {
+	mu         sync.Mutex
+	defaultTTL time.Duration
+	items      map[K]*ttlEntry[V]
+	stats      Stats
+	opts       options[K, V]
+	now        func() time.Time
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+// NewTTL creates a TTLCache whose entries expire after defaultTTL unless set with
+// SetWithTTL. A defaultTTL of NoExpiration keeps entries until they are removed.
+func NewTTL[K comparable, V any](defaultTTL time.Duration, opts ...Option[K, V]) *TTLCache[K, V] {
+	o := applyOptions(opts)
+	c := &TTLCache[K, V]{
+		defaultTTL: max(defaultTTL, NoExpiration),
+		items:      make(map[K]*ttlEntry[V]),
+		opts:       o,
+		now:        o.now,
+		done:       make(chan struct{}),
+	}
+	interval := c.opts.cleanupInterval
+	if interval == 0 {
+		interval = defaultCleanupInterval
+	}
+	go c.janitor(interval)
+	return c
+}
+
+func (c *TTLCache[K, V]) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.DeleteExpired()
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// Close stops the background janitor. The cache remains usable, but expired
+// entries are then only dropped on access or by DeleteExpired.
+func (c *TTLCache[K, V]) Close() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
+// lookup returns the live entry for key, dropping it if it has expired.
+// The caller must hold the lock.
+func (c *TTLCache[K, V]) lookup(key K, now time.Time) (*ttlEntry[V], []entry[K, V]) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, nil
+	}
+	if e.expired(now) {
+		delete(c.items, key)
+		c.stats.Evictions++
+		return nil, []entry[K, V]{{key: key, value: e.value}}
+	}
+	return e, nil
+}
+
+// Get returns the value for key if it has not expired.
+// With WithSliding, it also extends the entry's lifetime.
+func (c *TTLCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	now := c.now()
+	e, expired := c.lookup(key, now)
+	var value V
+	if e != nil {
+		c.stats.Hits++
+		if c.opts.sliding && e.ttl > 0 {
+			e.expiresAt = now.Add(e.ttl)
+		}
+		value = e.value
+	} else {
+		c.stats.Misses++
+	}
+	c.mu.Unlock()
+	c.opts.notifyExpired(expired)
+	return value, e != nil
+}
+
+// Peek returns the value for key if it has not expired, without extending
+// its lifetime or updating the stats.
+func (c *TTLCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok && !e.expired(c.now()) {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is present and has not expired.
+func (c *TTLCache[K, V]) Contains(key K) bool {
+	_, ok := c.Peek(key)
+	return ok
+}
+
+// TTL returns the time left before key expires, NoExpiration for entries that never
+// expire, and false if key is not present.
+func (c *TTLCache[K, V]) TTL(key K) (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	e, ok := c.items[key]
+	if !ok || e.expired(now) {
+		return 0, false
+	}
+	if e.ttl == NoExpiration {
+		return NoExpiration, true
+	}
+	return e.expiresAt.Sub(now), true
+}
+
+// Set sets the value for key with the default TTL.
+func (c *TTLCache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.defaultTTL)
+}
+
+// SetWithTTL sets the value for key, expiring it after ttl.
+// A ttl of NoExpiration keeps the entry until it is removed.
+func (c *TTLCache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := &ttlEntry[V]{value: value, ttl: max(ttl, NoExpiration)}
+	if e.ttl > 0 {
+		e.expiresAt = c.now().Add(e.ttl)
+	}
+	c.items[key] = e
+}
+
+// Put sets the value for key with the default TTL.
+// It never evicts other entries and always returns false.
+func (c *TTLCache[K, V]) Put(key K, value V) bool {
+	c.Set(key, value)
+	return false
+}
+
+// Remove removes key from the cache and reports whether it was present and live.
+func (c *TTLCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	delete(c.items, key)
+	return !e.expired(c.now())
+}
+
+// DeleteExpired drops all expired entries and invokes the expiry callback for them.
+func (c *TTLCache[K, V]) DeleteExpired() {
+	c.mu.Lock()
+	now := c.now()
+	var expired []entry[K, V]
+	for key, e := range c.items {
+		if e.expired(now) {
+			delete(c.items, key)
+			expired = append(expired, entry[K, V]{key: key, value: e.value})
+		}
+	}
+	c.stats.Evictions += uint64(len(expired))
+	c.mu.Unlock()
+	c.opts.notifyExpired(expired)
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// that have not been dropped yet.
+func (c *TTLCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Clear removes all entries from the cache without invoking the expiry callback.
+func (c *TTLCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.items)
+}
+
+// Stats returns a snapshot of the cache counters. Evictions counts expired entries.
+func (c *TTLCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
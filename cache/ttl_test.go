@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLCacheExpiry(t *testing.T) {
+	now := time.Unix(0, 0)
+	var expired []string
+	c := NewTTL(time.Second,
+		WithSliding[string, int](),
+		WithOnExpire(func(key string, _ int) { expired = append(expired, key) }),
+		WithClock[string, int](func() time.Time { return now }),
+	)
+	defer c.Close()
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.SetWithTTL("forever", 3, NoExpiration)
+
+	now = now.Add(800 * time.Millisecond)
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("expected a to be live")
+	}
+
+	now = now.Add(800 * time.Millisecond)
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("expected sliding expiration to keep a alive")
+	}
+	if _, ok := c.Peek("b"); ok {
+		t.Fatalf("expected b to be expired")
+	}
+
+	c.DeleteExpired()
+	if len(expired) != 1 || expired[0] != "b" {
+		t.Fatalf("expected b to be reported as expired, got %v", expired)
+	}
+	if ttl, ok := c.TTL("forever"); !ok || ttl != NoExpiration {
+		t.Fatalf("expected forever to never expire, got %v (ok=%v)", ttl, ok)
+	}
+	if c.Len() != 2 {
+		t.Fatalf("expected 2 entries, got %d", c.Len())
+	}
+}
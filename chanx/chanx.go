@@ -0,0 +1,114 @@
+package chanx
+
+import (
+	"context"
+	"sync"
+)
+
+// Merge returns a channel receiving every value from chs.
+// It is closed once all of chs are closed.
+func Merge[T any](chs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(chs))
+	for _, ch := range chs {
+		go func() {
+			defer wg.Done()
+			for v := range ch {
+				out <- v
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut distributes the values from in across n channels, each value going to
+// whichever channel is received from first. They are closed once in is closed.
+// An n less than 1 is treated as 1.
+func FanOut[T any](in <-chan T, n int) []<-chan T {
+	outs := make([]<-chan T, max(n, 1))
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for v := range in {
+				out <- v
+			}
+		}()
+	}
+	return outs
+}
+
+// Split routes the values from in to matched if f reports true for them and to
+// rest otherwise. Both are closed once in is closed. Values are forwarded in
+// order, so a stalled reader on either channel stalls both.
+func Split[T any](in <-chan T, f func(T) bool) (matched, rest <-chan T) {
+	yes, no := make(chan T), make(chan T)
+	go func() {
+		defer close(yes)
+		defer close(no)
+		for v := range in {
+			if f(v) {
+				yes <- v
+			} else {
+				no <- v
+			}
+		}
+	}()
+	return yes, no
+}
+
+// Tee copies every value from in to each of n channels, waiting until all have
+// received it before reading the next. They are closed once in is closed.
+// An n less than 1 is treated as 1.
+func Tee[T any](in <-chan T, n int) []<-chan T {
+	chs := make([]chan T, max(n, 1))
+	outs := make([]<-chan T, len(chs))
+	for i := range chs {
+		chs[i] = make(chan T)
+		outs[i] = chs[i]
+	}
+	go func() {
+		defer func() {
+			for _, ch := range chs {
+				close(ch)
+			}
+		}()
+		for v := range in {
+			for _, ch := range chs {
+				ch <- v
+			}
+		}
+	}()
+	return outs
+}
+
+// OrDone returns a channel receiving the values from ch until ch is closed or ctx is done.
+// It lets a consumer range over ch without leaking when it stops early.
+func OrDone[T any](ctx context.Context, ch <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
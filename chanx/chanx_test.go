@@ -0,0 +1,108 @@
+package chanx
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func source(values ...int) <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for _, v := range values {
+			ch <- v
+		}
+	}()
+	return ch
+}
+
+func collect(ch <-chan int) []int {
+	var values []int
+	for v := range ch {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestMerge(t *testing.T) {
+	got := collect(Merge(source(1, 2), source(3), source()))
+	slices.Sort(got)
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("unexpected merged values: %v", got)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	outs := FanOut(source(1, 2, 3, 4, 5), 3)
+	var (
+		mu  sync.Mutex
+		got []int
+		wg  sync.WaitGroup
+	)
+	for _, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values := collect(out)
+			mu.Lock()
+			got = append(got, values...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	slices.Sort(got)
+	if !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected every value exactly once, got %v", got)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	even, odd := Split(source(1, 2, 3, 4), func(v int) bool { return v%2 == 0 })
+	var wg sync.WaitGroup
+	var evens []int
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		evens = collect(even)
+	}()
+	odds := collect(odd)
+	wg.Wait()
+	if !slices.Equal(evens, []int{2, 4}) || !slices.Equal(odds, []int{1, 3}) {
+		t.Fatalf("unexpected split: %v, %v", evens, odds)
+	}
+}
+
+func TestTee(t *testing.T) {
+	outs := Tee(source(1, 2, 3), 2)
+	results := make([][]int, len(outs))
+	var wg sync.WaitGroup
+	for i, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = collect(out)
+		}()
+	}
+	wg.Wait()
+	for _, got := range results {
+		if !slices.Equal(got, []int{1, 2, 3}) {
+			t.Fatalf("expected every copy to see all values, got %v", got)
+		}
+	}
+}
+
+func TestOrDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := OrDone(ctx, in)
+	go func() { in <- 1 }()
+	if v := <-out; v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+	cancel()
+	if _, ok := <-out; ok {
+		t.Fatal("expected the channel to close after cancel")
+	}
+}
@@ -0,0 +1,211 @@
+package bitsets
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BitSet is a thread-safe growable set of non-negative integers stored as bits.
+type BitSet struct {
+	mu     sync.RWMutex
+	words  []uint64
+	length uint
+}
+
+// New creates a BitSet with room for size bits. It grows as needed.
+func New(size uint) *BitSet {
+	return &BitSet{words: make([]uint64, wordsFor(size)), length: size}
+}
+
+func wordsFor(n uint) int {
+	return int((n + 63) / 64)
+}
+
+func fromWords(words []uint64) *BitSet {
+	return &BitSet{words: words, length: uint(len(words)) * 64}
+}
+
+// grow makes room for bit i. The caller must hold the write lock.
+func (b *BitSet) grow(i uint) {
+	if i < b.length {
+		return
+	}
+	b.length = i + 1
+	if n := wordsFor(b.length); n > len(b.words) {
+		b.words = append(b.words, make([]uint64, n-len(b.words))...)
+	}
+}
+
+// Set sets bit i, growing the set if needed.
+func (b *BitSet) Set(i uint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.grow(i)
+	b.words[i/64] |= 1 << (i % 64)
+}
+
+// Clear clears bit i.
+func (b *BitSet) Clear(i uint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if i < b.length {
+		b.words[i/64] &^= 1 << (i % 64)
+	}
+}
+
+// Flip toggles bit i, growing the set if needed.
+func (b *BitSet) Flip(i uint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.grow(i)
+	b.words[i/64] ^= 1 << (i % 64)
+}
+
+// Test reports whether bit i is set.
+func (b *BitSet) Test(i uint) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return i < b.length && b.words[i/64]&(1<<(i%64)) != 0
+}
+
+// ClearAll clears every bit, keeping the length.
+func (b *BitSet) ClearAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	clear(b.words)
+}
+
+// Len returns the number of bits the set currently holds, set or not.
+func (b *BitSet) Len() uint {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.length
+}
+
+// Count returns the number of set bits.
+func (b *BitSet) Count() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// NextSet returns the first set bit at or after i.
+// It returns false if there is none.
+func (b *BitSet) NextSet(i uint) (uint, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	w := int(i / 64)
+	if w >= len(b.words) {
+		return 0, false
+	}
+	if word := b.words[w] >> (i % 64); word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < len(b.words); w++ {
+		if b.words[w] != 0 {
+			return uint(w)*64 + uint(bits.TrailingZeros64(b.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+// Range calls f for every set bit in ascending order.
+// If f returns false, iteration stops. f must not modify the set.
+func (b *BitSet) Range(f func(i uint) bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for w, word := range b.words {
+		for word != 0 {
+			i := uint(w)*64 + uint(bits.TrailingZeros64(word))
+			if !f(i) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// ToSlice returns the set bits in ascending order.
+func (b *BitSet) ToSlice() []uint {
+	var out []uint
+	b.Range(func(i uint) bool {
+		out = append(out, i)
+		return true
+	})
+	return out
+}
+
+func (b *BitSet) snapshot() []uint64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return append([]uint64(nil), b.words...)
+}
+
+// combine applies op word by word to b and other, treating missing words as zero.
+func (b *BitSet) combine(other *BitSet, op func(x, y uint64) uint64) *BitSet {
+	x, y := b.snapshot(), other.snapshot()
+	if len(x) < len(y) {
+		x = append(x, make([]uint64, len(y)-len(x))...)
+	}
+	for i := range x {
+		var w uint64
+		if i < len(y) {
+			w = y[i]
+		}
+		x[i] = op(x[i], w)
+	}
+	return fromWords(x)
+}
+
+// And returns a new BitSet with the bits set in both b and other.
+func (b *BitSet) And(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// Or returns a new BitSet with the bits set in b or other.
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor returns a new BitSet with the bits set in exactly one of b and other.
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// AndNot returns a new BitSet with the bits set in b but not in other.
+func (b *BitSet) AndNot(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// Equal reports whether b and other have the same bits set, regardless of length.
+func (b *BitSet) Equal(other *BitSet) bool {
+	return b.Xor(other).Count() == 0
+}
+
+// Clone returns a copy of the set.
+func (b *BitSet) Clone() *BitSet {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return &BitSet{words: append([]uint64(nil), b.words...), length: b.length}
+}
+
+// String returns the set bits formatted like {1 5 9}.
+func (b *BitSet) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	b.Range(func(i uint) bool {
+		if sb.Len() > 1 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(strconv.FormatUint(uint64(i), 10))
+		return true
+	})
+	sb.WriteByte('}')
+	return sb.String()
+}
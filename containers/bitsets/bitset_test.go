@@ -0,0 +1,64 @@
+package bitsets
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBitSet(t *testing.T) {
+	b := New(10)
+	b.Set(1)
+	b.Set(5)
+	b.Set(130)
+	if b.Len() != 131 {
+		t.Fatalf("expected set to grow to 131 bits, got %d", b.Len())
+	}
+	if !b.Test(130) || b.Test(2) || b.Test(1000) {
+		t.Fatalf("unexpected Test results: %s", b)
+	}
+	if b.Count() != 3 {
+		t.Fatalf("expected 3 set bits, got %d", b.Count())
+	}
+	if i, ok := b.NextSet(6); !ok || i != 130 {
+		t.Fatalf("expected next set bit 130, got %d (ok=%v)", i, ok)
+	}
+	if _, ok := b.NextSet(131); ok {
+		t.Fatalf("expected no set bit after 130")
+	}
+	b.Clear(5)
+	b.Flip(2)
+	if got := b.ToSlice(); !slices.Equal(got, []uint{1, 2, 130}) {
+		t.Fatalf("unexpected bits: %v", got)
+	}
+}
+
+func TestBitSetOps(t *testing.T) {
+	a, b := New(0), New(0)
+	for _, i := range []uint{1, 2, 3, 100} {
+		a.Set(i)
+	}
+	for _, i := range []uint{2, 3, 4} {
+		b.Set(i)
+	}
+	cases := []struct {
+		name string
+		got  *BitSet
+		want []uint
+	}{
+		{"And", a.And(b), []uint{2, 3}},
+		{"Or", a.Or(b), []uint{1, 2, 3, 4, 100}},
+		{"Xor", a.Xor(b), []uint{1, 4, 100}},
+		{"AndNot", a.AndNot(b), []uint{1, 100}},
+	}
+	for _, c := range cases {
+		if got := c.got.ToSlice(); !slices.Equal(got, c.want) {
+			t.Fatalf("%s: expected %v, got %v", c.name, c.want, got)
+		}
+	}
+	if !a.Equal(a.Clone()) || a.Equal(b) {
+		t.Fatalf("unexpected Equal results")
+	}
+	if s := b.String(); s != "{2 3 4}" {
+		t.Fatalf("unexpected String: %s", s)
+	}
+}
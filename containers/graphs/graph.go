@@ -0,0 +1,260 @@
+package graphs
+
+import (
+	"errors"
+	"slices"
+	"sync"
+)
+
+var (
+	// ErrCycle is returned by TopologicalSort when the graph has a cycle.
+	ErrCycle = errors.New("graphs: graph has a cycle")
+	// ErrUndirected is returned by TopologicalSort for undirected graphs.
+	ErrUndirected = errors.New("graphs: topological sort needs a directed graph")
+)
+
+// Graph is a thread-safe directed or undirected graph of comparable nodes.
+// Nodes and neighbours are reported in insertion order, so traversals are deterministic.
+type Graph[N comparable] struct {
+	mu       sync.RWMutex
+	directed bool
+	nodes    []N
+	edges    map[N][]N
+}
+
+// NewDirected creates an empty directed graph.
+func NewDirected[N comparable]() *Graph[N] {
+	return &Graph[N]{directed: true, edges: make(map[N][]N)}
+}
+
+// NewUndirected creates an empty undirected graph.
+func NewUndirected[N comparable]() *Graph[N] {
+	return &Graph[N]{edges: make(map[N][]N)}
+}
+
+// Directed reports whether the graph is directed.
+func (g *Graph[N]) Directed() bool {
+	return g.directed
+}
+
+// addNode adds n if it is missing. The caller must hold the write lock.
+func (g *Graph[N]) addNode(n N) {
+	if _, ok := g.edges[n]; !ok {
+		g.edges[n] = nil
+		g.nodes = append(g.nodes, n)
+	}
+}
+
+// AddNode adds n to the graph if it is not already present.
+func (g *Graph[N]) AddNode(n N) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.addNode(n)
+}
+
+// AddEdge adds an edge from one node to another, adding missing nodes.
+// In an undirected graph the edge is added in both directions.
+func (g *Graph[N]) AddEdge(from, to N) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.addNode(from)
+	g.addNode(to)
+	if !slices.Contains(g.edges[from], to) {
+		g.edges[from] = append(g.edges[from], to)
+	}
+	if !g.directed && !slices.Contains(g.edges[to], from) {
+		g.edges[to] = append(g.edges[to], from)
+	}
+}
+
+// RemoveEdge removes the edge between two nodes and reports whether it was present.
+func (g *Graph[N]) RemoveEdge(from, to N) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	i := slices.Index(g.edges[from], to)
+	if i < 0 {
+		return false
+	}
+	g.edges[from] = slices.Delete(g.edges[from], i, i+1)
+	if !g.directed {
+		if j := slices.Index(g.edges[to], from); j >= 0 {
+			g.edges[to] = slices.Delete(g.edges[to], j, j+1)
+		}
+	}
+	return true
+}
+
+// RemoveNode removes n and all its edges, and reports whether it was present.
+func (g *Graph[N]) RemoveNode(n N) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.edges[n]; !ok {
+		return false
+	}
+	delete(g.edges, n)
+	g.nodes = slices.DeleteFunc(g.nodes, func(m N) bool { return m == n })
+	for m, out := range g.edges {
+		g.edges[m] = slices.DeleteFunc(out, func(m N) bool { return m == n })
+	}
+	return true
+}
+
+// HasNode reports whether n is in the graph.
+func (g *Graph[N]) HasNode(n N) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	_, ok := g.edges[n]
+	return ok
+}
+
+// HasEdge reports whether there is an edge from one node to another.
+func (g *Graph[N]) HasEdge(from, to N) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return slices.Contains(g.edges[from], to)
+}
+
+// Neighbors returns the nodes n has an edge to.
+func (g *Graph[N]) Neighbors(n N) []N {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return slices.Clone(g.edges[n])
+}
+
+// Nodes returns all nodes in insertion order.
+func (g *Graph[N]) Nodes() []N {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return slices.Clone(g.nodes)
+}
+
+// Len returns the number of nodes in the graph.
+func (g *Graph[N]) Len() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return len(g.nodes)
+}
+
+// BFS calls f for every node reachable from start in breadth-first order.
+// If f returns false, the traversal stops. f must not modify the graph.
+func (g *Graph[N]) BFS(start N, f func(n N) bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if _, ok := g.edges[start]; !ok {
+		return
+	}
+	visited := map[N]bool{start: true}
+	queue := []N{start}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if !f(n) {
+			return
+		}
+		for _, m := range g.edges[n] {
+			if !visited[m] {
+				visited[m] = true
+				queue = append(queue, m)
+			}
+		}
+	}
+}
+
+// DFS calls f for every node reachable from start in depth-first preorder.
+// If f returns false, the traversal stops. f must not modify the graph.
+func (g *Graph[N]) DFS(start N, f func(n N) bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if _, ok := g.edges[start]; !ok {
+		return
+	}
+	visited := make(map[N]bool)
+	stack := []N{start}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[n] {
+			continue
+		}
+		visited[n] = true
+		if !f(n) {
+			return
+		}
+		// Push in reverse so neighbours are visited in insertion order.
+		out := g.edges[n]
+		for i := len(out) - 1; i >= 0; i-- {
+			if !visited[out[i]] {
+				stack = append(stack, out[i])
+			}
+		}
+	}
+}
+
+// TopologicalSort returns the nodes ordered so every edge points forward, breaking
+// ties by insertion order. It returns ErrCycle if the graph has a cycle and
+// ErrUndirected for undirected graphs.
+func (g *Graph[N]) TopologicalSort() ([]N, error) {
+	if !g.directed {
+		return nil, ErrUndirected
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	indegree := make(map[N]int, len(g.nodes))
+	for _, out := range g.edges {
+		for _, m := range out {
+			indegree[m]++
+		}
+	}
+	var queue []N
+	for _, n := range g.nodes {
+		if indegree[n] == 0 {
+			queue = append(queue, n)
+		}
+	}
+	order := make([]N, 0, len(g.nodes))
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		order = append(order, n)
+		for _, m := range g.edges[n] {
+			if indegree[m]--; indegree[m] == 0 {
+				queue = append(queue, m)
+			}
+		}
+	}
+	if len(order) != len(g.nodes) {
+		return nil, ErrCycle
+	}
+	return order, nil
+}
+
+// HasCycle reports whether the graph has a cycle. In an undirected graph an edge
+// back to the node it was reached from does not count as a cycle.
+func (g *Graph[N]) HasCycle() bool {
+	if g.directed {
+		_, err := g.TopologicalSort()
+		return err != nil
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	visited := make(map[N]bool, len(g.nodes))
+	var walk func(n, parent N, root bool) bool
+	walk = func(n, parent N, root bool) bool {
+		visited[n] = true
+		for _, m := range g.edges[n] {
+			if !root && m == parent {
+				continue
+			}
+			if visited[m] || walk(m, n, false) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, n := range g.nodes {
+		if !visited[n] && walk(n, n, true) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package graphs
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func collect[N comparable](traverse func(N, func(N) bool), start N) []N {
+	var out []N
+	traverse(start, func(n N) bool {
+		out = append(out, n)
+		return true
+	})
+	return out
+}
+
+func TestDirectedGraph(t *testing.T) {
+	g := NewDirected[string]()
+	g.AddEdge("api", "auth")
+	g.AddEdge("api", "db")
+	g.AddEdge("auth", "db")
+	g.AddEdge("db", "disk")
+
+	if got := collect(g.BFS, "api"); !slices.Equal(got, []string{"api", "auth", "db", "disk"}) {
+		t.Fatalf("unexpected BFS order: %v", got)
+	}
+	if got := collect(g.DFS, "api"); !slices.Equal(got, []string{"api", "auth", "db", "disk"}) {
+		t.Fatalf("unexpected DFS order: %v", got)
+	}
+	order, err := g.TopologicalSort()
+	if err != nil || !slices.Equal(order, []string{"api", "auth", "db", "disk"}) {
+		t.Fatalf("unexpected topological order: %v, %v", order, err)
+	}
+	if g.HasCycle() {
+		t.Fatalf("expected no cycle")
+	}
+
+	g.AddEdge("disk", "api")
+	if _, err := g.TopologicalSort(); !errors.Is(err, ErrCycle) {
+		t.Fatalf("expected ErrCycle, got %v", err)
+	}
+	g.RemoveNode("disk")
+	if g.HasCycle() || g.HasEdge("db", "disk") {
+		t.Fatalf("expected removing disk to break the cycle")
+	}
+}
+
+func TestUndirectedGraph(t *testing.T) {
+	g := NewUndirected[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	if !g.HasEdge(3, 2) {
+		t.Fatalf("expected undirected edge in both directions")
+	}
+	if g.HasCycle() {
+		t.Fatalf("expected a path to have no cycle")
+	}
+	g.AddEdge(3, 1)
+	if !g.HasCycle() {
+		t.Fatalf("expected a triangle to have a cycle")
+	}
+	if _, err := g.TopologicalSort(); !errors.Is(err, ErrUndirected) {
+		t.Fatalf("expected ErrUndirected, got %v", err)
+	}
+	if !g.RemoveEdge(1, 3) || g.HasEdge(3, 1) {
+		t.Fatalf("expected RemoveEdge to remove both directions")
+	}
+}
@@ -0,0 +1,247 @@
+package lists
+
+import "sync"
+
+// Element is an element of a linked List.
+type Element[T any] struct {
+	next, prev *Element[T]
+	list       *List[T]
+
+	// Value is the value stored with this element.
+	Value T
+}
+
+// Next returns the next list element or nil.
+func (e *Element[T]) Next() *Element[T] {
+	if p := e.next; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// Prev returns the previous list element or nil.
+func (e *Element[T]) Prev() *Element[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// noopLocker is used by lists that are not safe for concurrent use.
+type noopLocker struct{}
+
+func (noopLocker) Lock()   {}
+func (noopLocker) Unlock() {}
+
+// List is a generic doubly linked list.
+// Lists created by NewLocked guard their operations with a mutex;
+// walking elements with Next and Prev is never guarded, so use Range to
+// iterate a list that is modified concurrently.
+// The zero value is not usable; create lists with New or NewLocked.
+type List[T any] struct {
+	mu   sync.Locker
+	root Element[T]
+	len  int
+}
+
+// New creates an empty List that is not safe for concurrent use.
+func New[T any]() *List[T] {
+	return new(List[T]).init(noopLocker{})
+}
+
+// NewLocked creates an empty List whose operations are safe for concurrent use.
+func NewLocked[T any]() *List[T] {
+	return new(List[T]).init(&sync.Mutex{})
+}
+
+func (l *List[T]) init(mu sync.Locker) *List[T] {
+	l.mu = mu
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.len = 0
+	return l
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.len
+}
+
+// Front returns the first element of the list or nil if the list is empty.
+func (l *List[T]) Front() *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element of the list or nil if the list is empty.
+func (l *List[T]) Back() *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// Clear removes all elements from the list.
+func (l *List[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for e := l.root.next; e != &l.root; {
+		next := e.next
+		e.next, e.prev, e.list = nil, nil, nil
+		e = next
+	}
+	l.init(l.mu)
+}
+
+// insert inserts e after at and returns e.
+func (l *List[T]) insert(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.len++
+	return e
+}
+
+// remove removes e from its list.
+func (l *List[T]) remove(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next, e.prev, e.list = nil, nil, nil
+	l.len--
+}
+
+// move moves e to next to at.
+func (l *List[T]) move(e, at *Element[T]) {
+	if e == at {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// PushFront inserts a new element with value v at the front of the list and returns it.
+func (l *List[T]) PushFront(v T) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.insert(&Element[T]{Value: v}, &l.root)
+}
+
+// PushBack inserts a new element with value v at the back of the list and returns it.
+func (l *List[T]) PushBack(v T) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.insert(&Element[T]{Value: v}, l.root.prev)
+}
+
+// InsertBefore inserts a new element with value v immediately before mark and returns it.
+// It returns nil if mark is not an element of l.
+func (l *List[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: v}, mark.prev)
+}
+
+// InsertAfter inserts a new element with value v immediately after mark and returns it.
+// It returns nil if mark is not an element of l.
+func (l *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: v}, mark)
+}
+
+// Remove removes e from l if e is an element of l, and returns its value.
+func (l *List[T]) Remove(e *Element[T]) T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	v := e.Value
+	if e.list == l {
+		l.remove(e)
+	}
+	return v
+}
+
+// MoveToFront moves e to the front of l.
+// If e is not an element of l, the list is not modified.
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.move(e, &l.root)
+}
+
+// MoveToBack moves e to the back of l.
+// If e is not an element of l, the list is not modified.
+func (l *List[T]) MoveToBack(e *Element[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.move(e, l.root.prev)
+}
+
+// MoveBefore moves e to its new position before mark.
+// If e or mark is not an element of l, or e == mark, the list is not modified.
+func (l *List[T]) MoveBefore(e, mark *Element[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+	l.move(e, mark.prev)
+}
+
+// MoveAfter moves e to its new position after mark.
+// If e or mark is not an element of l, or e == mark, the list is not modified.
+func (l *List[T]) MoveAfter(e, mark *Element[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+	l.move(e, mark)
+}
+
+// Range calls f for each value of a snapshot of the list, from front to back.
+// If f returns false, iteration stops.
+func (l *List[T]) Range(f func(v T) bool) {
+	for _, v := range l.ToSlice() {
+		if !f(v) {
+			break
+		}
+	}
+}
+
+// ToSlice returns the values of the list as a slice, from front to back.
+func (l *List[T]) ToSlice() []T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	items := make([]T, 0, l.len)
+	for e := l.root.next; e != &l.root; e = e.next {
+		items = append(items, e.Value)
+	}
+	return items
+}
@@ -0,0 +1,34 @@
+package lists
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestListMoves(t *testing.T) {
+	l := NewLocked[int]()
+	e1 := l.PushBack(1)
+	e3 := l.PushBack(3)
+	l.InsertBefore(2, e3)
+	l.PushFront(0)
+
+	if got := l.ToSlice(); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Fatalf("unexpected order: %v", got)
+	}
+
+	l.MoveToFront(e3)
+	l.MoveToBack(e1)
+	if got := l.ToSlice(); !slices.Equal(got, []int{3, 0, 2, 1}) {
+		t.Fatalf("unexpected order after moves: %v", got)
+	}
+
+	if v := l.Remove(e3); v != 3 || l.Len() != 3 {
+		t.Fatalf("expected to remove 3, got %d with length %d", v, l.Len())
+	}
+	if e := l.InsertAfter(4, e3); e != nil {
+		t.Fatalf("expected insert after a removed element to fail")
+	}
+	if l.Front().Value != 0 || l.Back().Prev().Value != 2 {
+		t.Fatalf("unexpected neighbours: %v", l.ToSlice())
+	}
+}
@@ -0,0 +1,333 @@
+package maps
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/go-kratos/kit/tuple"
+)
+
+// btreeDegree is the minimum degree of the tree: every node other than the root
+// holds between btreeDegree-1 and 2*btreeDegree-1 entries.
+const btreeDegree = 32
+
+const (
+	btreeMinEntries = btreeDegree - 1
+	btreeMaxEntries = 2*btreeDegree - 1
+)
+
+type btreeEntry[K, V any] struct {
+	key   K
+	value V
+}
+
+type btreeNode[K, V any] struct {
+	entries  []btreeEntry[K, V]
+	children []*btreeNode[K, V]
+}
+
+func (n *btreeNode[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// BTreeMap is a thread-safe map ordered by key, backed by a B-tree.
+// Entries are packed into wide nodes, which keeps lookups and ordered iteration
+// cache friendly for in-memory indexes.
+type BTreeMap[K, V any] struct {
+	mu     sync.RWMutex
+	less   func(a, b K) bool
+	root   *btreeNode[K, V]
+	length int
+}
+
+// NewBTreeMap creates an empty BTreeMap ordered by the natural order of K.
+func NewBTreeMap[K cmp.Ordered, V any]() *BTreeMap[K, V] {
+	return NewBTreeMapFunc[K, V](cmp.Less[K])
+}
+
+// NewBTreeMapFunc creates an empty BTreeMap ordered by less.
+func NewBTreeMapFunc[K, V any](less func(a, b K) bool) *BTreeMap[K, V] {
+	return &BTreeMap[K, V]{less: less, root: &btreeNode[K, V]{}}
+}
+
+// search returns the index of the first entry in n not less than key,
+// and whether that entry holds key.
+func (m *BTreeMap[K, V]) search(n *btreeNode[K, V], key K) (int, bool) {
+	i := sort.Search(len(n.entries), func(i int) bool { return !m.less(n.entries[i].key, key) })
+	return i, i < len(n.entries) && !m.less(key, n.entries[i].key)
+}
+
+// Get retrieves the value for a given key.
+func (m *BTreeMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for n := m.root; ; {
+		i, found := m.search(n, key)
+		if found {
+			return n.entries[i].value, true
+		}
+		if n.leaf() {
+			var zero V
+			return zero, false
+		}
+		n = n.children[i]
+	}
+}
+
+// Set sets the value for a given key.
+func (m *BTreeMap[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.root.entries) >= btreeMaxEntries {
+		old := m.root
+		m.root = &btreeNode[K, V]{children: []*btreeNode[K, V]{old}}
+		m.splitChild(m.root, 0)
+	}
+	if !m.insert(m.root, btreeEntry[K, V]{key: key, value: value}) {
+		m.length++
+	}
+}
+
+// insert adds e below n, which must not be full, and reports whether an existing entry was replaced.
+func (m *BTreeMap[K, V]) insert(n *btreeNode[K, V], e btreeEntry[K, V]) bool {
+	for {
+		i, found := m.search(n, e.key)
+		if found {
+			n.entries[i].value = e.value
+			return true
+		}
+		if n.leaf() {
+			n.entries = slices.Insert(n.entries, i, e)
+			return false
+		}
+		if len(n.children[i].entries) >= btreeMaxEntries {
+			m.splitChild(n, i)
+			switch median := n.entries[i].key; {
+			case m.less(median, e.key):
+				i++
+			case !m.less(e.key, median):
+				n.entries[i].value = e.value
+				return true
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// splitChild splits the full child i of n, moving its median entry into n.
+func (m *BTreeMap[K, V]) splitChild(n *btreeNode[K, V], i int) {
+	child := n.children[i]
+	mid := btreeMaxEntries / 2
+	median := child.entries[mid]
+	right := &btreeNode[K, V]{entries: slices.Clone(child.entries[mid+1:])}
+	if !child.leaf() {
+		right.children = slices.Clone(child.children[mid+1:])
+		child.children = slices.Delete(child.children, mid+1, len(child.children))
+	}
+	child.entries = slices.Delete(child.entries, mid, len(child.entries))
+
+	n.entries = slices.Insert(n.entries, i, median)
+	n.children = slices.Insert(n.children, i+1, right)
+}
+
+// Delete removes the value for a given key and reports whether it was present.
+func (m *BTreeMap[K, V]) Delete(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	removed := m.remove(m.root, key)
+	if len(m.root.entries) == 0 && !m.root.leaf() {
+		m.root = m.root.children[0]
+	}
+	if removed {
+		m.length--
+	}
+	return removed
+}
+
+// remove deletes key from the subtree rooted at n. Every child it descends into
+// is first grown to hold more than the minimum number of entries, so the removal
+// never leaves a node underfull.
+func (m *BTreeMap[K, V]) remove(n *btreeNode[K, V], key K) bool {
+	for {
+		i, found := m.search(n, key)
+		if n.leaf() {
+			if !found {
+				return false
+			}
+			n.entries = slices.Delete(n.entries, i, i+1)
+			return true
+		}
+		if len(n.children[i].entries) <= btreeMinEntries {
+			m.growChild(n, i)
+			continue
+		}
+		if found {
+			// Replace the entry with its predecessor from the left subtree.
+			n.entries[i] = m.removeMax(n.children[i])
+			return true
+		}
+		n = n.children[i]
+	}
+}
+
+// removeMax removes and returns the largest entry below n.
+func (m *BTreeMap[K, V]) removeMax(n *btreeNode[K, V]) btreeEntry[K, V] {
+	for !n.leaf() {
+		i := len(n.children) - 1
+		if len(n.children[i].entries) <= btreeMinEntries {
+			m.growChild(n, i)
+			i = len(n.children) - 1
+		}
+		n = n.children[i]
+	}
+	last := len(n.entries) - 1
+	e := n.entries[last]
+	n.entries = slices.Delete(n.entries, last, last+1)
+	return e
+}
+
+// growChild gives child i of n an extra entry by borrowing from a sibling
+// or, when both siblings are minimal, merging it with one of them.
+func (m *BTreeMap[K, V]) growChild(n *btreeNode[K, V], i int) {
+	child := n.children[i]
+	switch {
+	case i > 0 && len(n.children[i-1].entries) > btreeMinEntries:
+		left := n.children[i-1]
+		last := len(left.entries) - 1
+		child.entries = slices.Insert(child.entries, 0, n.entries[i-1])
+		n.entries[i-1] = left.entries[last]
+		left.entries = slices.Delete(left.entries, last, last+1)
+		if !left.leaf() {
+			last := len(left.children) - 1
+			child.children = slices.Insert(child.children, 0, left.children[last])
+			left.children = slices.Delete(left.children, last, last+1)
+		}
+	case i < len(n.entries) && len(n.children[i+1].entries) > btreeMinEntries:
+		right := n.children[i+1]
+		child.entries = append(child.entries, n.entries[i])
+		n.entries[i] = right.entries[0]
+		right.entries = slices.Delete(right.entries, 0, 1)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+	default:
+		if i >= len(n.entries) {
+			i--
+			child = n.children[i]
+		}
+		right := n.children[i+1]
+		child.entries = append(child.entries, n.entries[i])
+		child.entries = append(child.entries, right.entries...)
+		child.children = append(child.children, right.children...)
+		n.entries = slices.Delete(n.entries, i, i+1)
+		n.children = slices.Delete(n.children, i+1, i+2)
+	}
+}
+
+// Len returns the number of entries in the map.
+func (m *BTreeMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.length
+}
+
+// Clear removes all entries from the map.
+func (m *BTreeMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.root = &btreeNode[K, V]{}
+	m.length = 0
+}
+
+// Ascend calls f for every entry in ascending key order.
+// If f returns false, iteration stops. f must not modify the map.
+func (m *BTreeMap[K, V]) Ascend(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	m.ascend(m.root, nil, nil, f)
+}
+
+// AscendRange calls f in ascending key order for every entry with
+// greaterOrEqual <= key < lessThan.
+// If f returns false, iteration stops. f must not modify the map.
+func (m *BTreeMap[K, V]) AscendRange(greaterOrEqual, lessThan K, f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	m.ascend(m.root, &greaterOrEqual, &lessThan, f)
+}
+
+// Descend calls f for every entry in descending key order.
+// If f returns false, iteration stops. f must not modify the map.
+func (m *BTreeMap[K, V]) Descend(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	m.descend(m.root, nil, nil, f)
+}
+
+// DescendRange calls f in descending key order for every entry with
+// lessOrEqual >= key > greaterThan.
+// If f returns false, iteration stops. f must not modify the map.
+func (m *BTreeMap[K, V]) DescendRange(lessOrEqual, greaterThan K, f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	m.descend(m.root, &lessOrEqual, &greaterThan, f)
+}
+
+func (m *BTreeMap[K, V]) ascend(n *btreeNode[K, V], lo, hi *K, f func(key K, value V) bool) bool {
+	i := 0
+	if lo != nil {
+		i, _ = m.search(n, *lo)
+	}
+	for ; i < len(n.entries); i++ {
+		if !n.leaf() && !m.ascend(n.children[i], lo, hi, f) {
+			return false
+		}
+		e := n.entries[i]
+		if hi != nil && !m.less(e.key, *hi) {
+			return false
+		}
+		if !f(e.key, e.value) {
+			return false
+		}
+	}
+	if !n.leaf() {
+		return m.ascend(n.children[len(n.children)-1], lo, hi, f)
+	}
+	return true
+}
+
+func (m *BTreeMap[K, V]) descend(n *btreeNode[K, V], hi, lo *K, f func(key K, value V) bool) bool {
+	i := len(n.entries) - 1
+	if hi != nil {
+		i = sort.Search(len(n.entries), func(i int) bool { return m.less(*hi, n.entries[i].key) }) - 1
+	}
+	if !n.leaf() && !m.descend(n.children[i+1], hi, lo, f) {
+		return false
+	}
+	for ; i >= 0; i-- {
+		e := n.entries[i]
+		if lo != nil && !m.less(*lo, e.key) {
+			return false
+		}
+		if !f(e.key, e.value) {
+			return false
+		}
+		if !n.leaf() && !m.descend(n.children[i], hi, lo, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// Entries returns the key-value pairs of the map in ascending key order.
+func (m *BTreeMap[K, V]) Entries() []tuple.Pair[K, V] {
+	var entries []tuple.Pair[K, V]
+	m.Ascend(func(key K, value V) bool {
+		entries = append(entries, tuple.NewPair(key, value))
+		return true
+	})
+	return entries
+}
@@ -0,0 +1,77 @@
+package maps
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBTreeMapMatchesMap(t *testing.T) {
+	m := NewBTreeMap[int, int]()
+	want := make(map[int]int)
+	r := rand.New(rand.NewSource(1))
+	for i := range 20000 {
+		k := r.Intn(3000)
+		if r.Intn(3) == 0 {
+			_, ok := want[k]
+			if m.Delete(k) != ok {
+				t.Fatalf("Delete(%d) disagreed with reference map", k)
+			}
+			delete(want, k)
+		} else {
+			m.Set(k, i)
+			want[k] = i
+		}
+	}
+	if m.Len() != len(want) {
+		t.Fatalf("expected %d entries, got %d", len(want), m.Len())
+	}
+
+	keys := make([]int, 0, len(want))
+	for k := range want {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var got []int
+	m.Ascend(func(k, v int) bool {
+		if v != want[k] {
+			t.Fatalf("expected %d=%d, got %d", k, want[k], v)
+		}
+		got = append(got, k)
+		return true
+	})
+	if !slices.Equal(got, keys) {
+		t.Fatalf("ascending keys do not match")
+	}
+
+	got = got[:0]
+	m.DescendRange(2000, 1000, func(k, _ int) bool {
+		got = append(got, k)
+		return true
+	})
+	var expected []int
+	for _, k := range slices.Backward(keys) {
+		if k <= 2000 && k > 1000 {
+			expected = append(expected, k)
+		}
+	}
+	if !slices.Equal(got, expected) {
+		t.Fatalf("descending range does not match")
+	}
+
+	got = got[:0]
+	m.AscendRange(1000, 2000, func(k, _ int) bool {
+		got = append(got, k)
+		return true
+	})
+	expected = expected[:0]
+	for _, k := range keys {
+		if k >= 1000 && k < 2000 {
+			expected = append(expected, k)
+		}
+	}
+	if !slices.Equal(got, expected) {
+		t.Fatalf("ascending range does not match")
+	}
+}
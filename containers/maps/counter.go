@@ -0,0 +1,102 @@
+package maps
+
+import (
+	"cmp"
+	"slices"
+	"sync/atomic"
+
+	"github.com/go-kratos/kit/tuple"
+)
+
+// Counter is a thread-safe set of int64 counters keyed by item.
+// Increments of existing items are a single atomic add, without locking.
+type Counter[T comparable] struct {
+	counts Map[T, *atomic.Int64]
+}
+
+// NewCounter creates an empty Counter.
+func NewCounter[T comparable]() *Counter[T] {
+	return &Counter[T]{}
+}
+
+func (c *Counter[T]) counter(item T) *atomic.Int64 {
+	if n, ok := c.counts.Load(item); ok {
+		return n
+	}
+	n, _ := c.counts.LoadOrStore(item, new(atomic.Int64))
+	return n
+}
+
+// Inc increments the count of item by one and returns the new count.
+func (c *Counter[T]) Inc(item T) int64 {
+	return c.Add(item, 1)
+}
+
+// Add adds delta to the count of item and returns the new count.
+func (c *Counter[T]) Add(item T, delta int64) int64 {
+	return c.counter(item).Add(delta)
+}
+
+// Get returns the count of item.
+func (c *Counter[T]) Get(item T) int64 {
+	if n, ok := c.counts.Load(item); ok {
+		return n.Load()
+	}
+	return 0
+}
+
+// Total returns the sum of all counts. It is computed by iterating the items, so
+// it never drifts from them, but it is not a snapshot under concurrent updates.
+func (c *Counter[T]) Total() int64 {
+	var total int64
+	c.Range(func(_ T, count int64) bool {
+		total += count
+		return true
+	})
+	return total
+}
+
+// Delete removes item and returns its count. An increment racing with Delete may be lost.
+func (c *Counter[T]) Delete(item T) int64 {
+	n, ok := c.counts.LoadAndDelete(item)
+	if !ok {
+		return 0
+	}
+	return n.Load()
+}
+
+// Len returns the number of distinct items.
+func (c *Counter[T]) Len() int {
+	n := 0
+	c.counts.Range(func(T, *atomic.Int64) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// Range calls f for every item and its count in unspecified order.
+// If f returns false, iteration stops.
+func (c *Counter[T]) Range(f func(item T, count int64) bool) {
+	c.counts.Range(func(item T, n *atomic.Int64) bool {
+		return f(item, n.Load())
+	})
+}
+
+// TopN returns up to n items with the highest counts, in descending order of count.
+func (c *Counter[T]) TopN(n int) []tuple.Pair[T, int64] {
+	var entries []tuple.Pair[T, int64]
+	c.Range(func(item T, count int64) bool {
+		entries = append(entries, tuple.NewPair(item, count))
+		return true
+	})
+	slices.SortFunc(entries, func(a, b tuple.Pair[T, int64]) int {
+		return cmp.Compare(b.Second, a.Second)
+	})
+	return entries[:min(max(n, 0), len(entries))]
+}
+
+// Clear removes all items.
+func (c *Counter[T]) Clear() {
+	c.counts.Clear()
+}
@@ -0,0 +1,59 @@
+package maps
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kit/tuple"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter[string]()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c.Inc("/users")
+			}
+			c.Add("/orders", 2)
+		}()
+	}
+	wg.Wait()
+	c.Inc("/health")
+
+	if got := c.Get("/users"); got != 5000 {
+		t.Fatalf("expected 5000 increments, got %d", got)
+	}
+	if got := c.Total(); got != 5101 {
+		t.Fatalf("expected total 5101, got %d", got)
+	}
+	top := c.TopN(2)
+	want := []tuple.Pair[string, int64]{{First: "/users", Second: 5000}, {First: "/orders", Second: 100}}
+	if len(top) != 2 || top[0] != want[0] || top[1] != want[1] {
+		t.Fatalf("unexpected top entries: %v", top)
+	}
+	if n := c.Delete("/users"); n != 5000 || c.Total() != 101 || c.Len() != 2 {
+		t.Fatalf("unexpected state after delete: n=%d total=%d len=%d", n, c.Total(), c.Len())
+	}
+}
+
+func TestCounterTotalAfterConcurrentDelete(t *testing.T) {
+	c := NewCounter[int]()
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 500 {
+				c.Inc(i % 2)
+				c.Delete(i % 2)
+			}
+		}()
+	}
+	wg.Wait()
+	if total, sum := c.Total(), c.Get(0)+c.Get(1); total != sum {
+		t.Fatalf("expected Total %d to match the counts %d", total, sum)
+	}
+}
@@ -0,0 +1,37 @@
+package maps
+
+// DefaultMap is a concurrent map that creates missing values with a factory.
+// The factory runs at most once per missing key, even under concurrent access,
+// and a slow factory for one key does not block other keys.
+type DefaultMap[K comparable, V any] struct {
+	m       Map[K, V]
+	factory func(K) V
+}
+
+// NewDefaultMap creates and returns a new DefaultMap using factory to build missing values.
+func NewDefaultMap[K comparable, V any](factory func(K) V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{factory: factory}
+}
+
+// Load retrieves the value for a given key, creating it via the factory if missing.
+func (m *DefaultMap[K, V]) Load(key K) V {
+	value, _ := m.m.LoadOrStoreFunc(key, func() (V, error) {
+		return m.factory(key), nil
+	})
+	return value
+}
+
+// Get retrieves the value for a given key without invoking the factory.
+func (m *DefaultMap[K, V]) Get(key K) (V, bool) {
+	return m.m.Load(key)
+}
+
+// Delete removes the value for a given key, so the next Load creates it again.
+func (m *DefaultMap[K, V]) Delete(key K) {
+	m.m.Delete(key)
+}
+
+// Range iterates over all key-value pairs in the map.
+func (m *DefaultMap[K, V]) Range(f func(key K, value V) bool) {
+	m.m.Range(f)
+}
@@ -0,0 +1,61 @@
+package maps
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDefaultMapCreatesOncePerKey(t *testing.T) {
+	var calls int32
+	m := NewDefaultMap(func(key string) *sync.Mutex {
+		atomic.AddInt32(&calls, 1)
+		return &sync.Mutex{}
+	})
+
+	var wg sync.WaitGroup
+	results := make([]*sync.Mutex, 16)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = m.Load("a")
+		}(i)
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected factory to run once, got %d", calls)
+	}
+	for _, mu := range results {
+		if mu != results[0] {
+			t.Fatalf("expected all callers to share the same value")
+		}
+	}
+	if _, ok := m.Get("b"); ok {
+		t.Fatalf("expected Get to not create missing values")
+	}
+}
+
+func TestDefaultMapSlowFactoryDoesNotBlockOtherKeys(t *testing.T) {
+	release := make(chan struct{})
+	m := NewDefaultMap(func(key string) int {
+		if key == "slow" {
+			<-release
+		}
+		return len(key)
+	})
+	done := make(chan int)
+	go func() { done <- m.Load("slow") }()
+	if v := m.Load("fast"); v != 4 {
+		t.Fatalf("expected 4, got %d", v)
+	}
+	close(release)
+	if v := <-done; v != 4 {
+		t.Fatalf("expected 4, got %d", v)
+	}
+	m.Delete("fast")
+	if _, ok := m.Get("fast"); ok {
+		t.Fatal("expected fast to be deleted")
+	}
+}
@@ -0,0 +1,237 @@
+package maps
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"slices"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+var hamtSeed = maphash.MakeSeed()
+
+type hamtEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// hamtLeaf holds the entries whose keys share a full 64-bit hash.
+type hamtLeaf[K comparable, V any] struct {
+	hash    uint64
+	entries []hamtEntry[K, V]
+}
+
+type hamtSlot[K comparable, V any] struct {
+	child *hamtNode[K, V]
+	leaf  *hamtLeaf[K, V]
+}
+
+// hamtNode stores only its occupied slots; bitmap records which of the 32 are present.
+// Nodes are never modified once they are reachable from an ImmutableMap.
+type hamtNode[K comparable, V any] struct {
+	bitmap uint32
+	slots  []hamtSlot[K, V]
+}
+
+func (n *hamtNode[K, V]) locate(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hamtNode[K, V]) withSlot(i int, slot hamtSlot[K, V]) *hamtNode[K, V] {
+	slots := slices.Clone(n.slots)
+	slots[i] = slot
+	return &hamtNode[K, V]{bitmap: n.bitmap, slots: slots}
+}
+
+// ImmutableMap is an immutable map backed by a hash array mapped trie. Set and
+// Delete return new maps that share all but O(log32 n) nodes with the original,
+// so snapshots and versions are cheap and can be read from any goroutine
+// without locking. Like ImmutableSlice it is a value type; the zero value is an
+// empty map.
+type ImmutableMap[K comparable, V any] struct {
+	root   *hamtNode[K, V]
+	length int
+}
+
+// NewImmutableMap creates an ImmutableMap holding the entries of the given maps.
+func NewImmutableMap[K comparable, V any](ms ...map[K]V) ImmutableMap[K, V] {
+	var m ImmutableMap[K, V]
+	for _, src := range ms {
+		for k, v := range src {
+			m = m.Set(k, v)
+		}
+	}
+	return m
+}
+
+// Len returns the number of entries in the map.
+func (m ImmutableMap[K, V]) Len() int {
+	return m.length
+}
+
+// Get retrieves the value for a given key.
+func (m ImmutableMap[K, V]) Get(key K) (V, bool) {
+	hash := maphash.Comparable(hamtSeed, key)
+	for n, shift := m.root, uint(0); n != nil; shift += hamtBits {
+		bit, i := n.locate(hash, shift)
+		if n.bitmap&bit == 0 {
+			break
+		}
+		if slot := n.slots[i]; slot.leaf != nil {
+			if slot.leaf.hash == hash {
+				for _, e := range slot.leaf.entries {
+					if e.key == key {
+						return e.value, true
+					}
+				}
+			}
+			break
+		}
+		n = n.slots[i].child
+	}
+	var zero V
+	return zero, false
+}
+
+// Has reports whether key is in the map.
+func (m ImmutableMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Set returns a new map with key set to value.
+func (m ImmutableMap[K, V]) Set(key K, value V) ImmutableMap[K, V] {
+	root := m.root
+	if root == nil {
+		root = &hamtNode[K, V]{}
+	}
+	hash := maphash.Comparable(hamtSeed, key)
+	root, added := setHAMT(root, hash, 0, hamtEntry[K, V]{key, value})
+	m.root = root
+	if added {
+		m.length++
+	}
+	return m
+}
+
+func setHAMT[K comparable, V any](n *hamtNode[K, V], hash uint64, shift uint, e hamtEntry[K, V]) (*hamtNode[K, V], bool) {
+	bit, i := n.locate(hash, shift)
+	if n.bitmap&bit == 0 {
+		leaf := &hamtLeaf[K, V]{hash: hash, entries: []hamtEntry[K, V]{e}}
+		return &hamtNode[K, V]{bitmap: n.bitmap | bit, slots: slices.Insert(slices.Clone(n.slots), i, hamtSlot[K, V]{leaf: leaf})}, true
+	}
+	slot := n.slots[i]
+	if slot.child != nil {
+		child, added := setHAMT(slot.child, hash, shift+hamtBits, e)
+		return n.withSlot(i, hamtSlot[K, V]{child: child}), added
+	}
+	if slot.leaf.hash == hash {
+		entries := slices.Clone(slot.leaf.entries)
+		added := true
+		if j := slices.IndexFunc(entries, func(old hamtEntry[K, V]) bool { return old.key == e.key }); j >= 0 {
+			entries[j], added = e, false
+		} else {
+			entries = append(entries, e)
+		}
+		return n.withSlot(i, hamtSlot[K, V]{leaf: &hamtLeaf[K, V]{hash: hash, entries: entries}}), added
+	}
+	// Two different hashes share this slot: push the existing leaf down a level.
+	child := &hamtNode[K, V]{}
+	childBit, _ := child.locate(slot.leaf.hash, shift+hamtBits)
+	child.bitmap = childBit
+	child.slots = []hamtSlot[K, V]{slot}
+	child, _ = setHAMT(child, hash, shift+hamtBits, e)
+	return n.withSlot(i, hamtSlot[K, V]{child: child}), true
+}
+
+// Delete returns a new map without key.
+// It returns the map unchanged and false if key is not present.
+func (m ImmutableMap[K, V]) Delete(key K) (ImmutableMap[K, V], bool) {
+	if m.root == nil {
+		return m, false
+	}
+	root, removed := deleteHAMT(m.root, maphash.Comparable(hamtSeed, key), 0, key)
+	if !removed {
+		return m, false
+	}
+	m.root = root
+	m.length--
+	return m, true
+}
+
+func deleteHAMT[K comparable, V any](n *hamtNode[K, V], hash uint64, shift uint, key K) (*hamtNode[K, V], bool) {
+	bit, i := n.locate(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	slot := n.slots[i]
+	if slot.child != nil {
+		child, removed := deleteHAMT(slot.child, hash, shift+hamtBits, key)
+		if !removed {
+			return n, false
+		}
+		switch {
+		case len(child.slots) == 0:
+			return n.withoutSlot(bit, i), true
+		case len(child.slots) == 1 && child.slots[0].leaf != nil:
+			// Pull a lone leaf back up instead of keeping a chain of single-slot nodes.
+			return n.withSlot(i, child.slots[0]), true
+		}
+		return n.withSlot(i, hamtSlot[K, V]{child: child}), true
+	}
+	if slot.leaf.hash != hash {
+		return n, false
+	}
+	j := slices.IndexFunc(slot.leaf.entries, func(e hamtEntry[K, V]) bool { return e.key == key })
+	if j < 0 {
+		return n, false
+	}
+	if len(slot.leaf.entries) == 1 {
+		return n.withoutSlot(bit, i), true
+	}
+	entries := slices.Delete(slices.Clone(slot.leaf.entries), j, j+1)
+	return n.withSlot(i, hamtSlot[K, V]{leaf: &hamtLeaf[K, V]{hash: hash, entries: entries}}), true
+}
+
+func (n *hamtNode[K, V]) withoutSlot(bit uint32, i int) *hamtNode[K, V] {
+	return &hamtNode[K, V]{bitmap: n.bitmap &^ bit, slots: slices.Delete(slices.Clone(n.slots), i, i+1)}
+}
+
+// Range calls f for every entry in unspecified order.
+// If f returns false, iteration stops.
+func (m ImmutableMap[K, V]) Range(f func(key K, value V) bool) {
+	if m.root != nil {
+		m.root.walk(f)
+	}
+}
+
+func (n *hamtNode[K, V]) walk(f func(key K, value V) bool) bool {
+	for _, slot := range n.slots {
+		if slot.child != nil {
+			if !slot.child.walk(f) {
+				return false
+			}
+			continue
+		}
+		for _, e := range slot.leaf.entries {
+			if !f(e.key, e.value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ToMap creates and returns a shallow copy of the map as a standard map.
+func (m ImmutableMap[K, V]) ToMap() map[K]V {
+	clone := make(map[K]V, m.length)
+	m.Range(func(key K, value V) bool {
+		clone[key] = value
+		return true
+	})
+	return clone
+}
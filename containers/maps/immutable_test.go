@@ -0,0 +1,56 @@
+package maps
+
+import (
+	"maps"
+	"math/rand"
+	"testing"
+)
+
+func TestImmutableMapMatchesMap(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	m := NewImmutableMap[int, int]()
+	want := make(map[int]int)
+	var snapshots []ImmutableMap[int, int]
+	var expected []map[int]int
+	for step := 0; step < 20000; step++ {
+		k := r.Intn(3000)
+		if r.Intn(3) == 0 {
+			var removed bool
+			m, removed = m.Delete(k)
+			if _, ok := want[k]; ok != removed {
+				t.Fatalf("step %d: Delete(%d) reported %v", step, k, removed)
+			}
+			delete(want, k)
+		} else {
+			m = m.Set(k, step)
+			want[k] = step
+		}
+		if step%2000 == 0 {
+			snapshots = append(snapshots, m)
+			expected = append(expected, maps.Clone(want))
+		}
+	}
+	if m.Len() != len(want) || !maps.Equal(m.ToMap(), want) {
+		t.Fatalf("map diverged from reference map")
+	}
+	for i, s := range snapshots {
+		if !maps.Equal(s.ToMap(), expected[i]) || s.Len() != len(expected[i]) {
+			t.Fatalf("snapshot %d was modified by later operations", i)
+		}
+	}
+}
+
+func TestImmutableMap(t *testing.T) {
+	a := NewImmutableMap(map[string]int{"x": 1})
+	b := a.Set("y", 2)
+	if a.Has("y") || !b.Has("y") {
+		t.Fatalf("expected Set to leave the original unchanged")
+	}
+	if _, ok := a.Delete("missing"); ok {
+		t.Fatalf("expected deleting a missing key to report false")
+	}
+	var zero ImmutableMap[string, int]
+	if v, ok := zero.Set("k", 3).Get("k"); !ok || v != 3 {
+		t.Fatalf("expected the zero value to be usable, got %d (ok=%v)", v, ok)
+	}
+}
@@ -3,11 +3,15 @@ package maps
 import (
 	"encoding/json"
 	"sync"
+
+	"github.com/go-kratos/kit/singleflight"
+	"github.com/go-kratos/kit/tuple"
 )
 
 // Map is a concurrent map with generic key and value types.
 type Map[K comparable, V any] struct {
-	m sync.Map
+	m      sync.Map
+	flight singleflight.Singleflight[K, V]
 }
 
 // New creates and returns a new Map instance.
@@ -70,6 +74,27 @@ func (m *Map[K, V]) LoadOrStore(key K, value V) (V, bool) {
 	return loaded.(V), true
 }
 
+// LoadOrStoreFunc retrieves the existing value for a key, or stores and returns the
+// value computed by fn if the key is not present. Concurrent calls for the same
+// missing key share one call to fn. If fn fails, nothing is stored.
+func (m *Map[K, V]) LoadOrStoreFunc(key K, fn func() (V, error)) (V, error) {
+	if value, ok := m.Load(key); ok {
+		return value, nil
+	}
+	value, err, _ := m.flight.Do(key, func() (V, error) {
+		if value, ok := m.Load(key); ok {
+			return value, nil
+		}
+		value, err := fn()
+		if err != nil {
+			return value, err
+		}
+		value, _ = m.LoadOrStore(key, value)
+		return value, nil
+	})
+	return value, err
+}
+
 // Range iterates over all key-value pairs in the map.
 func (m *Map[K, V]) Range(f func(key K, value V) bool) {
 	m.m.Range(func(key, value any) bool {
@@ -97,6 +122,16 @@ func (m *Map[K, V]) ToMap() map[K]V {
 	return clone
 }
 
+// Entries returns the key-value pairs of the map in unspecified order.
+func (m *Map[K, V]) Entries() []tuple.Pair[K, V] {
+	var entries []tuple.Pair[K, V]
+	m.Range(func(key K, value V) bool {
+		entries = append(entries, tuple.NewPair(key, value))
+		return true
+	})
+	return entries
+}
+
 // Clone creates and returns a shallow copy of the Map.
 func (m *Map[K, V]) Clone() *Map[K, V] {
 	clone := New[K, V]()
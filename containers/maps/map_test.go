@@ -0,0 +1,39 @@
+package maps
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMapLoadOrStoreFunc(t *testing.T) {
+	m := New[string, int]()
+	errFailed := errors.New("failed")
+	if _, err := m.LoadOrStoreFunc("a", func() (int, error) { return 0, errFailed }); !errors.Is(err, errFailed) {
+		t.Fatalf("expected the error, got %v", err)
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Fatal("expected a failed call not to store a value")
+	}
+
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := m.LoadOrStoreFunc("a", func() (int, error) {
+				calls.Add(1)
+				return 1, nil
+			})
+			if err != nil || v != 1 {
+				t.Errorf("unexpected result: %d, %v", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected a single call, got %d", n)
+	}
+}
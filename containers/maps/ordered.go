@@ -0,0 +1,257 @@
+package maps
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/go-kratos/kit/containers/lists"
+	"github.com/go-kratos/kit/tuple"
+)
+
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// OrderedMap is a thread-safe map that remembers the order in which keys were
+// first inserted. Range, Keys and JSON encoding follow that order, which makes
+// output deterministic for signing and reproducible config dumps.
+// The zero value is an empty map ready to use.
+type OrderedMap[K comparable, V any] struct {
+	mu    sync.RWMutex
+	items map[K]*lists.Element[orderedEntry[K, V]]
+	order *lists.List[orderedEntry[K, V]]
+}
+
+// NewOrderedMap creates an empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		items: make(map[K]*lists.Element[orderedEntry[K, V]]),
+		order: lists.New[orderedEntry[K, V]](),
+	}
+}
+
+// Get retrieves the value for a given key.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if e, ok := m.items[key]; ok {
+		return e.Value.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has reports whether key is in the map.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.items[key]
+	return ok
+}
+
+// Set sets the value for a given key. A new key is added at the back;
+// an existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.set(key, value)
+}
+
+func (m *OrderedMap[K, V]) set(key K, value V) {
+	if m.items == nil {
+		m.items = make(map[K]*lists.Element[orderedEntry[K, V]])
+		m.order = lists.New[orderedEntry[K, V]]()
+	}
+	if e, ok := m.items[key]; ok {
+		e.Value.value = value
+		return
+	}
+	m.items[key] = m.order.PushBack(orderedEntry[K, V]{key: key, value: value})
+}
+
+// Delete removes the value for a given key and reports whether it was present.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.items[key]
+	if !ok {
+		return false
+	}
+	m.order.Remove(e)
+	delete(m.items, key)
+	return true
+}
+
+// MoveToFront moves key to the front of the order and reports whether it was present.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.items[key]
+	if ok {
+		m.order.MoveToFront(e)
+	}
+	return ok
+}
+
+// MoveToBack moves key to the back of the order and reports whether it was present.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.items[key]
+	if ok {
+		m.order.MoveToBack(e)
+	}
+	return ok
+}
+
+// Len returns the number of entries in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.items)
+}
+
+// Clear removes all entries from the map.
+func (m *OrderedMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	clear(m.items)
+	if m.order != nil {
+		m.order.Clear()
+	}
+}
+
+// Range calls f for every entry in order.
+// If f returns false, iteration stops. f must not modify the map.
+func (m *OrderedMap[K, V]) Range(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.order == nil {
+		return
+	}
+	m.order.Range(func(e orderedEntry[K, V]) bool {
+		return f(e.key, e.value)
+	})
+}
+
+// Keys returns the keys in order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	m.Range(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Entries returns the key-value pairs of the map in order.
+func (m *OrderedMap[K, V]) Entries() []tuple.Pair[K, V] {
+	var entries []tuple.Pair[K, V]
+	m.Range(func(key K, value V) bool {
+		entries = append(entries, tuple.NewPair(key, value))
+		return true
+	})
+	return entries
+}
+
+// MarshalJSON encodes the map as a JSON object with keys in order.
+// Keys are encoded like encoding/json map keys: strings, integers or text marshalers;
+// other key types return a *json.UnsupportedTypeError.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	var err error
+	m.Range(func(key K, value V) bool {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		var name string
+		if name, err = encodeKey(key); err != nil {
+			return false
+		}
+		var k, v []byte
+		if k, err = json.Marshal(name); err != nil {
+			return false
+		}
+		if v, err = json.Marshal(value); err != nil {
+			return false
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map, keeping the document's key order.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil {
+		return err
+	} else if t != json.Delim('{') {
+		return fmt.Errorf("maps: expected JSON object for OrderedMap, got %v", t)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := decodeKey[K](t.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		m.set(key, value)
+	}
+	_, err := dec.Token()
+	return err
+}
+
+// encodeKey returns the JSON object key for key, resolved the way encoding/json
+// resolves map keys: string kinds as-is, then text marshalers, then integers.
+func encodeKey[K comparable](key K) (string, error) {
+	rv := reflect.ValueOf(key)
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		if rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return "", nil
+		}
+		b, err := tm.MarshalText()
+		return string(b), err
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return "", &json.UnsupportedTypeError{Type: reflect.TypeOf(key)}
+}
+
+func decodeKey[K any](s string) (K, error) {
+	var key K
+	if err := json.Unmarshal([]byte(strconv.Quote(s)), &key); err == nil {
+		return key, nil
+	}
+	// Integer keys are quoted in JSON objects but decode from bare numbers.
+	err := json.Unmarshal([]byte(s), &key)
+	return key, err
+}
@@ -0,0 +1,100 @@
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestOrderedMap(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	m.Set("b", 4)
+	if got := m.Keys(); !slices.Equal(got, []string{"b", "a", "c"}) {
+		t.Fatalf("unexpected key order: %v", got)
+	}
+	m.MoveToFront("c")
+	m.MoveToBack("b")
+	m.Delete("missing")
+	if got := m.Keys(); !slices.Equal(got, []string{"c", "a", "b"}) {
+		t.Fatalf("unexpected key order after moves: %v", got)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil || string(data) != `{"c":3,"a":2,"b":4}` {
+		t.Fatalf("unexpected JSON: %s, %v", data, err)
+	}
+	decoded := NewOrderedMap[string, int]()
+	if err := json.Unmarshal([]byte(`{"z":1,"y":2,"x":3}`), decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded.Keys(); !slices.Equal(got, []string{"z", "y", "x"}) {
+		t.Fatalf("expected document order to be kept, got %v", got)
+	}
+}
+
+func TestOrderedMapIntKeysJSON(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	m.Set(10, "x")
+	m.Set(2, "y")
+	data, err := json.Marshal(m)
+	if err != nil || string(data) != `{"10":"x","2":"y"}` {
+		t.Fatalf("unexpected JSON: %s, %v", data, err)
+	}
+	var decoded OrderedMap[int, string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded.Keys(); !slices.Equal(got, []int{10, 2}) {
+		t.Fatalf("unexpected decoded keys: %v", got)
+	}
+}
+
+type textKey struct{ a, b int }
+
+func (k textKey) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d-%d", k.a, k.b)), nil
+}
+
+func TestOrderedMapKeyEncoding(t *testing.T) {
+	type name string
+	names := NewOrderedMap[name, int]()
+	names.Set(`a"b`, 1)
+	if data, err := json.Marshal(names); err != nil || string(data) != `{"a\"b":1}` {
+		t.Fatalf("unexpected JSON for string keys: %s, %v", data, err)
+	}
+	texts := NewOrderedMap[textKey, int]()
+	texts.Set(textKey{1, 2}, 3)
+	if data, err := json.Marshal(texts); err != nil || string(data) != `{"1-2":3}` {
+		t.Fatalf("unexpected JSON for text marshaler keys: %s, %v", data, err)
+	}
+	uints := NewOrderedMap[uint8, int]()
+	uints.Set(7, 1)
+	if data, err := json.Marshal(uints); err != nil || string(data) != `{"7":1}` {
+		t.Fatalf("unexpected JSON for unsigned keys: %s, %v", data, err)
+	}
+
+	floats := NewOrderedMap[float64, int]()
+	floats.Set(1.5, 1)
+	var unsupported *json.UnsupportedTypeError
+	if _, err := json.Marshal(floats); !errors.As(err, &unsupported) {
+		t.Fatalf("expected an unsupported key type error, got %v", err)
+	}
+}
+
+func TestOrderedMapZeroValue(t *testing.T) {
+	var m OrderedMap[string, int]
+	if m.Len() != 0 || len(m.Keys()) != 0 || m.Delete("a") || m.MoveToFront("a") {
+		t.Fatal("expected the zero value to be empty")
+	}
+	m.Clear()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	if got := m.Keys(); !slices.Equal(got, []string{"b", "a"}) {
+		t.Fatalf("unexpected key order: %v", got)
+	}
+}
@@ -0,0 +1,275 @@
+package maps
+
+import (
+	"cmp"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-kratos/kit/tuple"
+)
+
+const (
+	skipListMaxLevel = 32
+	skipListP        = 0.25
+)
+
+type skipListNode[K cmp.Ordered, V any] struct {
+	key         K
+	value       atomic.Pointer[V]
+	next        []atomic.Pointer[skipListNode[K, V]]
+	mu          sync.Mutex
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+func newSkipListNode[K cmp.Ordered, V any](key K, level int) *skipListNode[K, V] {
+	return &skipListNode[K, V]{key: key, next: make([]atomic.Pointer[skipListNode[K, V]], level)}
+}
+
+func (n *skipListNode[K, V]) level() int {
+	return len(n.next)
+}
+
+// SkipListMap is a concurrent map ordered by key.
+// It is a lazy skip list: lookups and iteration never lock, while writers only
+// lock the few nodes adjacent to the key they change, so operations are O(log n)
+// and do not serialize on a global lock.
+type SkipListMap[K cmp.Ordered, V any] struct {
+	head   *skipListNode[K, V]
+	length atomic.Int64
+}
+
+// NewSkipListMap creates and returns a new SkipListMap instance.
+func NewSkipListMap[K cmp.Ordered, V any](ms ...map[K]V) *SkipListMap[K, V] {
+	m := &SkipListMap[K, V]{head: newSkipListNode[K, V](*new(K), skipListMaxLevel)}
+	for _, n := range ms {
+		for k, v := range n {
+			m.Store(k, v)
+		}
+	}
+	return m
+}
+
+func randomSkipListLevel() int {
+	level := 1
+	for level < skipListMaxLevel && rand.Float64() < skipListP {
+		level++
+	}
+	return level
+}
+
+// find fills preds and succs with the nodes surrounding key on every level and
+// returns the highest level at which a node holding key was found, or -1.
+func (m *SkipListMap[K, V]) find(key K, preds, succs *[skipListMaxLevel]*skipListNode[K, V]) int {
+	found := -1
+	pred := m.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && curr.key < key {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if found == -1 && curr != nil && curr.key == key {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return found
+}
+
+// unlockPreds unlocks the distinct predecessors locked on levels [0, highest].
+func unlockPreds[K cmp.Ordered, V any](preds *[skipListMaxLevel]*skipListNode[K, V], highest int) {
+	for level := 0; level <= highest; level++ {
+		if level == 0 || preds[level] != preds[level-1] {
+			preds[level].mu.Unlock()
+		}
+	}
+}
+
+// Load retrieves the value for a given key.
+func (m *SkipListMap[K, V]) Load(key K) (V, bool) {
+	pred := m.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && curr.key < key {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if curr != nil && curr.key == key {
+			if curr.fullyLinked.Load() && !curr.marked.Load() {
+				return *curr.value.Load(), true
+			}
+			break
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Store sets the value for a given key.
+func (m *SkipListMap[K, V]) Store(key K, value V) {
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	level := randomSkipListLevel()
+	for {
+		if found := m.find(key, &preds, &succs); found != -1 {
+			node := succs[found]
+			if !node.marked.Load() {
+				// Another writer may still be linking the node in.
+				for !node.fullyLinked.Load() {
+					runtime.Gosched()
+				}
+				node.value.Store(&value)
+				return
+			}
+			// The node is being removed; retry once it is unlinked.
+			continue
+		}
+		highest, valid := -1, true
+		var prev *skipListNode[K, V]
+		for l := 0; valid && l < level; l++ {
+			pred, succ := preds[l], succs[l]
+			if pred != prev {
+				pred.mu.Lock()
+				highest, prev = l, pred
+			}
+			valid = !pred.marked.Load() && (succ == nil || !succ.marked.Load()) && pred.next[l].Load() == succ
+		}
+		if !valid {
+			unlockPreds(&preds, highest)
+			continue
+		}
+		node := newSkipListNode[K, V](key, level)
+		node.value.Store(&value)
+		for l := 0; l < level; l++ {
+			node.next[l].Store(succs[l])
+		}
+		for l := 0; l < level; l++ {
+			preds[l].next[l].Store(node)
+		}
+		node.fullyLinked.Store(true)
+		unlockPreds(&preds, highest)
+		m.length.Add(1)
+		return
+	}
+}
+
+// LoadAndDelete retrieves and deletes the value for a given key.
+func (m *SkipListMap[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	var (
+		preds, succs [skipListMaxLevel]*skipListNode[K, V]
+		victim       *skipListNode[K, V]
+	)
+	for {
+		found := m.find(key, &preds, &succs)
+		if victim == nil {
+			if found == -1 {
+				return value, false
+			}
+			node := succs[found]
+			if !node.fullyLinked.Load() || node.level()-1 != found || node.marked.Load() {
+				return value, false
+			}
+			node.mu.Lock()
+			if node.marked.Load() {
+				node.mu.Unlock()
+				return value, false
+			}
+			node.marked.Store(true)
+			victim = node
+		}
+		highest, valid := -1, true
+		var prev *skipListNode[K, V]
+		for l := 0; valid && l < victim.level(); l++ {
+			pred := preds[l]
+			if pred != prev {
+				pred.mu.Lock()
+				highest, prev = l, pred
+			}
+			valid = !pred.marked.Load() && pred.next[l].Load() == victim
+		}
+		if !valid {
+			unlockPreds(&preds, highest)
+			continue
+		}
+		for l := victim.level() - 1; l >= 0; l-- {
+			preds[l].next[l].Store(victim.next[l].Load())
+		}
+		victim.mu.Unlock()
+		unlockPreds(&preds, highest)
+		m.length.Add(-1)
+		return *victim.value.Load(), true
+	}
+}
+
+// Delete removes the value for a given key.
+func (m *SkipListMap[K, V]) Delete(key K) {
+	m.LoadAndDelete(key)
+}
+
+// Len returns the number of entries in the map.
+func (m *SkipListMap[K, V]) Len() int {
+	return int(m.length.Load())
+}
+
+// Clear removes all entries from the map.
+func (m *SkipListMap[K, V]) Clear() {
+	m.Range(func(key K, _ V) bool {
+		m.Delete(key)
+		return true
+	})
+}
+
+// Range iterates over all key-value pairs in ascending key order.
+// Like sync.Map.Range, it does not correspond to a consistent snapshot:
+// entries stored or deleted concurrently may or may not be visited.
+func (m *SkipListMap[K, V]) Range(f func(key K, value V) bool) {
+	m.rangeFrom(m.head.next[0].Load(), func(K) bool { return true }, f)
+}
+
+// RangeBetween iterates in ascending key order over the key-value pairs whose keys
+// are within the inclusive bounds [from, to]. It has the same consistency as Range.
+func (m *SkipListMap[K, V]) RangeBetween(from, to K, f func(key K, value V) bool) {
+	pred := m.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && curr.key < from {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+	}
+	m.rangeFrom(pred.next[0].Load(), func(key K) bool { return key <= to }, f)
+}
+
+func (m *SkipListMap[K, V]) rangeFrom(n *skipListNode[K, V], within func(K) bool, f func(key K, value V) bool) {
+	for ; n != nil && within(n.key); n = n.next[0].Load() {
+		if !n.fullyLinked.Load() || n.marked.Load() {
+			continue
+		}
+		if !f(n.key, *n.value.Load()) {
+			return
+		}
+	}
+}
+
+// Entries returns the key-value pairs of the map in ascending key order.
+func (m *SkipListMap[K, V]) Entries() []tuple.Pair[K, V] {
+	var entries []tuple.Pair[K, V]
+	m.Range(func(key K, value V) bool {
+		entries = append(entries, tuple.NewPair(key, value))
+		return true
+	})
+	return entries
+}
+
+// ToMap creates and returns a shallow copy of the map as a standard map.
+func (m *SkipListMap[K, V]) ToMap() map[K]V {
+	clone := make(map[K]V)
+	m.Range(func(key K, value V) bool {
+		clone[key] = value
+		return true
+	})
+	return clone
+}
@@ -0,0 +1,58 @@
+package maps
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSkipListMapConcurrentOrdered(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < 1000; i += 8 {
+				m.Store(i, i*10)
+				if i%3 == 0 {
+					m.Delete(i)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	prev, count := -1, 0
+	m.Range(func(k, v int) bool {
+		if k <= prev {
+			t.Fatalf("keys out of order: %d after %d", k, prev)
+		}
+		if k%3 == 0 || v != k*10 {
+			t.Fatalf("unexpected entry %d=%d", k, v)
+		}
+		prev = k
+		count++
+		return true
+	})
+	if count != m.Len() || count != 666 {
+		t.Fatalf("expected 666 entries, ranged %d with Len %d", count, m.Len())
+	}
+
+	var between []int
+	m.RangeBetween(10, 20, func(k, _ int) bool {
+		between = append(between, k)
+		return true
+	})
+	want := []int{10, 11, 13, 14, 16, 17, 19, 20}
+	if len(between) != len(want) {
+		t.Fatalf("expected %v, got %v", want, between)
+	}
+	for i := range want {
+		if between[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, between)
+		}
+	}
+	if v, ok := m.Load(11); !ok || v != 110 {
+		t.Fatalf("expected 110, got %d (ok=%v)", v, ok)
+	}
+}
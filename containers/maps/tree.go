@@ -0,0 +1,341 @@
+package maps
+
+import (
+	"cmp"
+	"sync"
+
+	"github.com/go-kratos/kit/tuple"
+)
+
+type treeNode[K, V any] struct {
+	key         K
+	value       V
+	left, right *treeNode[K, V]
+	red         bool
+}
+
+func (h *treeNode[K, V]) isRed() bool {
+	return h != nil && h.red
+}
+
+func (h *treeNode[K, V]) rotateLeft() *treeNode[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *treeNode[K, V]) rotateRight() *treeNode[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *treeNode[K, V]) flipColors() {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func (h *treeNode[K, V]) balance() *treeNode[K, V] {
+	if h.right.isRed() && !h.left.isRed() {
+		h = h.rotateLeft()
+	}
+	if h.left.isRed() && h.left.left.isRed() {
+		h = h.rotateRight()
+	}
+	if h.left.isRed() && h.right.isRed() {
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *treeNode[K, V]) moveRedLeft() *treeNode[K, V] {
+	h.flipColors()
+	if h.right.left.isRed() {
+		h.right = h.right.rotateRight()
+		h = h.rotateLeft()
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *treeNode[K, V]) moveRedRight() *treeNode[K, V] {
+	h.flipColors()
+	if h.left.left.isRed() {
+		h = h.rotateRight()
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *treeNode[K, V]) deleteMin() *treeNode[K, V] {
+	if h.left == nil {
+		return nil
+	}
+	if !h.left.isRed() && !h.left.left.isRed() {
+		h = h.moveRedLeft()
+	}
+	h.left = h.left.deleteMin()
+	return h.balance()
+}
+
+// TreeMap is a thread-safe map ordered by key, backed by a left-leaning red-black tree.
+// Besides O(log n) lookups it answers neighbour queries such as Floor and Ceiling.
+type TreeMap[K, V any] struct {
+	mu     sync.RWMutex
+	less   func(a, b K) bool
+	root   *treeNode[K, V]
+	length int
+}
+
+// NewTreeMap creates an empty TreeMap ordered by the natural order of K.
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](cmp.Less[K])
+}
+
+// NewTreeMapFunc creates an empty TreeMap ordered by less.
+func NewTreeMapFunc[K, V any](less func(a, b K) bool) *TreeMap[K, V] {
+	return &TreeMap[K, V]{less: less}
+}
+
+func (m *TreeMap[K, V]) find(key K) *treeNode[K, V] {
+	n := m.root
+	for n != nil {
+		switch {
+		case m.less(key, n.key):
+			n = n.left
+		case m.less(n.key, key):
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Get retrieves the value for a given key.
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if n := m.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set sets the value for a given key.
+func (m *TreeMap[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.root = m.insert(m.root, key, value)
+	m.root.red = false
+}
+
+func (m *TreeMap[K, V]) insert(h *treeNode[K, V], key K, value V) *treeNode[K, V] {
+	if h == nil {
+		m.length++
+		return &treeNode[K, V]{key: key, value: value, red: true}
+	}
+	switch {
+	case m.less(key, h.key):
+		h.left = m.insert(h.left, key, value)
+	case m.less(h.key, key):
+		h.right = m.insert(h.right, key, value)
+	default:
+		h.value = value
+	}
+	return h.balance()
+}
+
+// Delete removes the value for a given key and reports whether it was present.
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.find(key) == nil {
+		return false
+	}
+	if !m.root.left.isRed() && !m.root.right.isRed() {
+		m.root.red = true
+	}
+	m.root = m.delete(m.root, key)
+	if m.root != nil {
+		m.root.red = false
+	}
+	m.length--
+	return true
+}
+
+// delete removes key, which must be present, from the subtree rooted at h.
+func (m *TreeMap[K, V]) delete(h *treeNode[K, V], key K) *treeNode[K, V] {
+	if m.less(key, h.key) {
+		if !h.left.isRed() && !h.left.left.isRed() {
+			h = h.moveRedLeft()
+		}
+		h.left = m.delete(h.left, key)
+		return h.balance()
+	}
+	if h.left.isRed() {
+		h = h.rotateRight()
+	}
+	if !m.less(h.key, key) && h.right == nil {
+		return nil
+	}
+	if !h.right.isRed() && !h.right.left.isRed() {
+		h = h.moveRedRight()
+	}
+	if !m.less(h.key, key) {
+		successor := h.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		h.key, h.value = successor.key, successor.value
+		h.right = h.right.deleteMin()
+	} else {
+		h.right = m.delete(h.right, key)
+	}
+	return h.balance()
+}
+
+// Len returns the number of entries in the map.
+func (m *TreeMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.length
+}
+
+// Clear removes all entries from the map.
+func (m *TreeMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.root = nil
+	m.length = 0
+}
+
+func (h *treeNode[K, V]) entry() (K, V, bool) {
+	if h == nil {
+		var (
+			key   K
+			value V
+		)
+		return key, value, false
+	}
+	return h.key, h.value, true
+}
+
+// First returns the entry with the smallest key.
+// It returns false if the map is empty.
+func (m *TreeMap[K, V]) First() (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	n := m.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return n.entry()
+}
+
+// Last returns the entry with the largest key.
+// It returns false if the map is empty.
+func (m *TreeMap[K, V]) Last() (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	n := m.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return n.entry()
+}
+
+// Floor returns the entry with the largest key less than or equal to key.
+// It returns false if there is none.
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return m.below(key, true)
+}
+
+// Lower returns the entry with the largest key strictly less than key.
+// It returns false if there is none.
+func (m *TreeMap[K, V]) Lower(key K) (K, V, bool) {
+	return m.below(key, false)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key.
+// It returns false if there is none.
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.above(key, true)
+}
+
+// Higher returns the entry with the smallest key strictly greater than key.
+// It returns false if there is none.
+func (m *TreeMap[K, V]) Higher(key K) (K, V, bool) {
+	return m.above(key, false)
+}
+
+func (m *TreeMap[K, V]) below(key K, inclusive bool) (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var best *treeNode[K, V]
+	for n := m.root; n != nil; {
+		switch {
+		case m.less(n.key, key):
+			best, n = n, n.right
+		case m.less(key, n.key):
+			n = n.left
+		case inclusive:
+			return n.entry()
+		default:
+			n = n.left
+		}
+	}
+	return best.entry()
+}
+
+func (m *TreeMap[K, V]) above(key K, inclusive bool) (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var best *treeNode[K, V]
+	for n := m.root; n != nil; {
+		switch {
+		case m.less(key, n.key):
+			best, n = n, n.left
+		case m.less(n.key, key):
+			n = n.right
+		case inclusive:
+			return n.entry()
+		default:
+			n = n.right
+		}
+	}
+	return best.entry()
+}
+
+// Range calls f for every entry in ascending key order.
+// If f returns false, iteration stops. f must not modify the map.
+func (m *TreeMap[K, V]) Range(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	m.root.inorder(f)
+}
+
+func (h *treeNode[K, V]) inorder(f func(key K, value V) bool) bool {
+	if h == nil {
+		return true
+	}
+	return h.left.inorder(f) && f(h.key, h.value) && h.right.inorder(f)
+}
+
+// Entries returns the key-value pairs of the map in ascending key order.
+func (m *TreeMap[K, V]) Entries() []tuple.Pair[K, V] {
+	var entries []tuple.Pair[K, V]
+	m.Range(func(key K, value V) bool {
+		entries = append(entries, tuple.NewPair(key, value))
+		return true
+	})
+	return entries
+}
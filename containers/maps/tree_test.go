@@ -0,0 +1,77 @@
+package maps
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/go-kratos/kit/tuple"
+)
+
+func TestTreeMapNeighbours(t *testing.T) {
+	m := NewTreeMap[int, string]()
+	for _, k := range []int{10, 20, 30, 40} {
+		m.Set(k, "v")
+	}
+
+	check := func(name string, got int, ok bool, want int, wantOK bool) {
+		t.Helper()
+		if ok != wantOK || (ok && got != want) {
+			t.Fatalf("%s: expected %d (ok=%v), got %d (ok=%v)", name, want, wantOK, got, ok)
+		}
+	}
+	k, _, ok := m.Floor(25)
+	check("Floor(25)", k, ok, 20, true)
+	k, _, ok = m.Floor(20)
+	check("Floor(20)", k, ok, 20, true)
+	k, _, ok = m.Lower(20)
+	check("Lower(20)", k, ok, 10, true)
+	k, _, ok = m.Ceiling(25)
+	check("Ceiling(25)", k, ok, 30, true)
+	k, _, ok = m.Higher(40)
+	check("Higher(40)", k, ok, 0, false)
+	k, _, ok = m.First()
+	check("First", k, ok, 10, true)
+	k, _, ok = m.Last()
+	check("Last", k, ok, 40, true)
+}
+
+func TestTreeMapMatchesMap(t *testing.T) {
+	m := NewTreeMap[int, int]()
+	want := make(map[int]int)
+	r := rand.New(rand.NewSource(1))
+	for i := range 20000 {
+		k := r.Intn(2000)
+		if r.Intn(3) == 0 {
+			_, ok := want[k]
+			if m.Delete(k) != ok {
+				t.Fatalf("Delete(%d) disagreed with reference map", k)
+			}
+			delete(want, k)
+		} else {
+			m.Set(k, i)
+			want[k] = i
+		}
+	}
+	if m.Len() != len(want) {
+		t.Fatalf("expected %d entries, got %d", len(want), m.Len())
+	}
+	prev := -1
+	m.Range(func(k, v int) bool {
+		if k <= prev || want[k] != v {
+			t.Fatalf("unexpected entry %d=%d after %d", k, v, prev)
+		}
+		prev = k
+		return true
+	})
+}
+
+func TestTreeMapEntries(t *testing.T) {
+	m := NewTreeMap[string, int]()
+	m.Set("b", 2)
+	m.Set("a", 1)
+	want := []tuple.Pair[string, int]{tuple.NewPair("a", 1), tuple.NewPair("b", 2)}
+	if got := m.Entries(); !slices.Equal(got, want) {
+		t.Fatalf("expected entries %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,64 @@
+package queues
+
+import (
+	"context"
+	"sync"
+)
+
+// BlockingQueue is implemented by every queue in this package. PutCtx blocks while
+// a bounded queue is full and TakeCtx blocks while the queue is empty; both give up
+// with the context's error once ctx is done.
+type BlockingQueue[T any] interface {
+	PutCtx(ctx context.Context, item T) error
+	TakeCtx(ctx context.Context) (T, error)
+}
+
+var (
+	_ BlockingQueue[any] = (*Queue[any])(nil)
+	_ BlockingQueue[any] = (*Deque[any])(nil)
+	_ BlockingQueue[any] = (*PriorityQueue[any])(nil)
+	_ BlockingQueue[any] = (*RingBuffer[any])(nil)
+	_ BlockingQueue[any] = (*BoundedQueue[any])(nil)
+	_ BlockingQueue[any] = (*MPMCQueue[any])(nil)
+	_ BlockingQueue[any] = (*DelayQueue[any])(nil)
+)
+
+// signal wakes every goroutine waiting for a queue state change. Waiters take the
+// channel while holding the queue lock and block on it after unlocking, so a
+// broadcast between the two is never missed. It is guarded by the queue's lock.
+type signal struct {
+	ch chan struct{}
+}
+
+func (s *signal) wait() <-chan struct{} {
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	return s.ch
+}
+
+func (s *signal) broadcast() {
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+}
+
+// await calls try under mu until it succeeds, waiting on s between attempts.
+// It returns ctx.Err() if ctx is done before try succeeds.
+func await(ctx context.Context, mu sync.Locker, s *signal, try func() bool) error {
+	for {
+		mu.Lock()
+		if try() {
+			mu.Unlock()
+			return nil
+		}
+		wait := s.wait()
+		mu.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package queues
+
+import (
+	"context"
+	"sync"
+)
+
+// BoundedQueue is a thread-safe FIFO queue with a fixed capacity. Put blocks while
+// the queue is full and Take blocks while it is empty, which gives producers
+// backpressure from slow consumers.
+type BoundedQueue[T any] struct {
+	mu       sync.Mutex
+	r        ring[T]
+	capacity int
+	notEmpty signal
+	notFull  signal
+}
+
+// NewBoundedQueue creates an empty BoundedQueue holding at most capacity items.
+// A capacity less than 1 is treated as 1.
+func NewBoundedQueue[T any](capacity int) *BoundedQueue[T] {
+	return &BoundedQueue[T]{capacity: max(capacity, 1)}
+}
+
+// Put adds item to the back of the queue, blocking until there is room.
+func (q *BoundedQueue[T]) Put(item T) {
+	_ = q.PutCtx(context.Background(), item)
+}
+
+// PutCtx adds item to the back of the queue, blocking until there is room or ctx is done.
+func (q *BoundedQueue[T]) PutCtx(ctx context.Context, item T) error {
+	return await(ctx, &q.mu, &q.notFull, func() bool {
+		if q.r.len() >= q.capacity {
+			return false
+		}
+		q.push(item)
+		return true
+	})
+}
+
+// TryPut adds item to the back of the queue without blocking.
+// It returns false if the queue is full.
+func (q *BoundedQueue[T]) TryPut(item T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.r.len() >= q.capacity {
+		return false
+	}
+	q.push(item)
+	return true
+}
+
+func (q *BoundedQueue[T]) push(item T) {
+	q.r.pushBack(item)
+	q.notEmpty.broadcast()
+}
+
+// Take removes and returns the item at the front of the queue,
+// blocking until one is available.
+func (q *BoundedQueue[T]) Take() T {
+	item, _ := q.TakeCtx(context.Background())
+	return item
+}
+
+// TakeCtx removes and returns the item at the front of the queue,
+// blocking until one is available or ctx is done.
+func (q *BoundedQueue[T]) TakeCtx(ctx context.Context) (T, error) {
+	var item T
+	err := await(ctx, &q.mu, &q.notEmpty, func() (ok bool) {
+		item, ok = q.pop()
+		return ok
+	})
+	return item, err
+}
+
+// TryTake removes and returns the item at the front of the queue without blocking.
+// It returns false if the queue is empty.
+func (q *BoundedQueue[T]) TryTake() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.pop()
+}
+
+func (q *BoundedQueue[T]) pop() (T, bool) {
+	item, ok := q.r.popFront()
+	if ok {
+		q.notFull.broadcast()
+	}
+	return item, ok
+}
+
+// Peek returns the item at the front of the queue without removing it.
+// It returns false if the queue is empty.
+func (q *BoundedQueue[T]) Peek() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.r.front()
+}
+
+// Len returns the number of items in the queue.
+func (q *BoundedQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.r.len()
+}
+
+// Cap returns the capacity of the queue.
+func (q *BoundedQueue[T]) Cap() int {
+	return q.capacity
+}
+
+// Clear removes all items from the queue, unblocking waiting producers.
+func (q *BoundedQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.r.clear()
+	q.notFull.broadcast()
+}
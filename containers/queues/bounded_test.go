@@ -0,0 +1,98 @@
+package queues
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBoundedQueue(t *testing.T) {
+	q := NewBoundedQueue[int](2)
+	if !q.TryPut(1) || !q.TryPut(2) || q.TryPut(3) {
+		t.Fatalf("expected TryPut to fail only when full")
+	}
+
+	put := make(chan struct{})
+	go func() {
+		q.Put(3)
+		close(put)
+	}()
+	select {
+	case <-put:
+		t.Fatalf("expected Put to block while full")
+	case <-time.After(10 * time.Millisecond):
+	}
+	if v := q.Take(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+	<-put
+	if got := q.Len(); got != 2 {
+		t.Fatalf("expected 2 items, got %d", got)
+	}
+}
+
+func TestBoundedQueueProducerConsumer(t *testing.T) {
+	q := NewBoundedQueue[int](4)
+	const producers, items = 4, 250
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < items; i++ {
+				q.Put(i)
+			}
+		}()
+	}
+	sum := 0
+	for i := 0; i < producers*items; i++ {
+		sum += q.Take()
+	}
+	wg.Wait()
+	if want := producers * items * (items - 1) / 2; sum != want {
+		t.Fatalf("expected sum %d, got %d", want, sum)
+	}
+	if _, ok := q.TryTake(); ok {
+		t.Fatalf("expected queue to be drained")
+	}
+}
+
+func TestBlockingQueueCancellation(t *testing.T) {
+	queues := map[string]BlockingQueue[int]{
+		"Queue":         New[int](),
+		"Deque":         NewDeque[int](),
+		"PriorityQueue": NewPriorityQueue(func(a, b int) bool { return a < b }),
+		"RingBuffer":    NewRingBuffer[int](1, Reject),
+		"BoundedQueue":  NewBoundedQueue[int](1),
+	}
+	for name, q := range queues {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		if _, err := q.TakeCtx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("%s: expected TakeCtx on empty queue to time out, got %v", name, err)
+		}
+		cancel()
+
+		got := make(chan int)
+		go func() {
+			v, _ := q.TakeCtx(context.Background())
+			got <- v
+		}()
+		time.Sleep(time.Millisecond)
+		if err := q.PutCtx(context.Background(), 7); err != nil {
+			t.Fatalf("%s: unexpected PutCtx error: %v", name, err)
+		}
+		if v := <-got; v != 7 {
+			t.Fatalf("%s: expected blocked TakeCtx to receive 7, got %d", name, v)
+		}
+	}
+
+	q := NewBoundedQueue[int](1)
+	q.Put(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := q.PutCtx(ctx, 2); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected PutCtx on full queue to be canceled, got %v", err)
+	}
+}
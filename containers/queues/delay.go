@@ -0,0 +1,136 @@
+package queues
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type delayed[T any] struct {
+	value T
+	at    time.Time
+}
+
+// DelayQueue is a thread-safe queue whose items become available at a ready time.
+// Take blocks until the item with the earliest ready time is due, so a single
+// consumer can drive retries or scheduled work without a timer per item.
+type DelayQueue[T any] struct {
+	mu      sync.Mutex
+	pq      *PriorityQueue[delayed[T]]
+	changed signal
+}
+
+// NewDelayQueue creates an empty DelayQueue.
+func NewDelayQueue[T any]() *DelayQueue[T] {
+	return &DelayQueue[T]{pq: NewPriorityQueue(func(a, b delayed[T]) bool {
+		return a.at.Before(b.at)
+	})}
+}
+
+// Put adds value to the queue, to become available at readyAt.
+func (q *DelayQueue[T]) Put(value T, readyAt time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.pq.Push(delayed[T]{value: value, at: readyAt})
+	q.changed.broadcast()
+}
+
+// PutAfter adds value to the queue, to become available after delay.
+func (q *DelayQueue[T]) PutAfter(value T, delay time.Duration) {
+	q.Put(value, time.Now().Add(delay))
+}
+
+// PutCtx adds value to the queue as immediately available. The queue is unbounded,
+// so it only fails if ctx is already done.
+func (q *DelayQueue[T]) PutCtx(ctx context.Context, value T) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	q.Put(value, time.Now())
+	return nil
+}
+
+// Take removes and returns the earliest item, blocking until it is due.
+func (q *DelayQueue[T]) Take() T {
+	value, _ := q.TakeCtx(context.Background())
+	return value
+}
+
+// TakeCtx removes and returns the earliest item, blocking until it is due or ctx is done.
+// An item added with an earlier ready time while waiting is picked up at its own time.
+func (q *DelayQueue[T]) TakeCtx(ctx context.Context) (T, error) {
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	for {
+		q.mu.Lock()
+		head, ok := q.pq.Peek()
+		var delay time.Duration
+		if ok {
+			if delay = time.Until(head.at); delay <= 0 {
+				q.pq.Pop()
+				q.mu.Unlock()
+				return head.value, nil
+			}
+		}
+		wait := q.changed.wait()
+		q.mu.Unlock()
+
+		var due <-chan time.Time
+		if ok {
+			if timer == nil {
+				timer = time.NewTimer(delay)
+			} else {
+				timer.Reset(delay)
+			}
+			due = timer.C
+		}
+		select {
+		case <-wait:
+		case <-due:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// TryTake removes and returns the earliest item without blocking.
+// It returns false if the queue is empty or the earliest item is not yet due.
+func (q *DelayQueue[T]) TryTake() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if head, ok := q.pq.Peek(); ok && !head.at.After(time.Now()) {
+		q.pq.Pop()
+		return head.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Peek returns the earliest item and its ready time without removing it.
+// It returns false if the queue is empty.
+func (q *DelayQueue[T]) Peek() (T, time.Time, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	head, ok := q.pq.Peek()
+	return head.value, head.at, ok
+}
+
+// Len returns the number of items in the queue, due or not.
+func (q *DelayQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.pq.Len()
+}
+
+// Clear removes all items from the queue.
+func (q *DelayQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.pq.Clear()
+	q.changed.broadcast()
+}
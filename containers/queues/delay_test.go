@@ -0,0 +1,63 @@
+package queues
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDelayQueue(t *testing.T) {
+	q := NewDelayQueue[string]()
+	q.PutAfter("late", 60*time.Millisecond)
+	q.PutAfter("early", 20*time.Millisecond)
+	if _, ok := q.TryTake(); ok {
+		t.Fatal("expected no item to be due yet")
+	}
+	if v, _, ok := q.Peek(); !ok || v != "early" {
+		t.Fatalf("expected early at the head, got %q", v)
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for _, want := range []string{"early", "late"} {
+		got, err := q.TakeCtx(ctx)
+		if err != nil || got != want {
+			t.Fatalf("expected %q, got %q, %v", want, got, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Fatalf("items were taken before they were due: %v", elapsed)
+	}
+	if q.Len() != 0 {
+		t.Fatalf("expected empty queue, got %d", q.Len())
+	}
+}
+
+func TestDelayQueueEarlierItemWakesTaker(t *testing.T) {
+	q := NewDelayQueue[int]()
+	q.PutAfter(1, time.Hour)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		q.PutAfter(2, 10*time.Millisecond)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if got, err := q.TakeCtx(ctx); err != nil || got != 2 {
+		t.Fatalf("expected 2, got %d, %v", got, err)
+	}
+}
+
+func TestDelayQueueCancel(t *testing.T) {
+	q := NewDelayQueue[int]()
+	q.PutAfter(1, time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.TakeCtx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if q.Len() != 1 {
+		t.Fatalf("expected the item to stay queued, got %d", q.Len())
+	}
+}
@@ -0,0 +1,128 @@
+package queues
+
+import (
+	"context"
+	"sync"
+)
+
+// Deque is a thread-safe generic double-ended queue.
+// It is backed by a growable circular buffer, so pushes and pops at either end are amortized O(1).
+type Deque[T any] struct {
+	mu       sync.RWMutex
+	r        ring[T]
+	notEmpty signal
+}
+
+// NewDeque creates a Deque with optional initial items, the first at the front.
+func NewDeque[T any](items ...T) *Deque[T] {
+	d := &Deque[T]{}
+	d.PushBack(items...)
+	return d
+}
+
+// PushBack adds items to the back of the deque.
+func (d *Deque[T]) PushBack(items ...T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, item := range items {
+		d.r.pushBack(item)
+	}
+	d.notEmpty.broadcast()
+}
+
+// PushFront adds items to the front of the deque, preserving their order,
+// so the first item ends up at the front.
+func (d *Deque[T]) PushFront(items ...T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i := len(items) - 1; i >= 0; i-- {
+		d.r.pushFront(items[i])
+	}
+	d.notEmpty.broadcast()
+}
+
+// PutCtx adds item to the back of the deque. The deque is unbounded, so it only
+// fails if ctx is already done.
+func (d *Deque[T]) PutCtx(ctx context.Context, item T) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	d.PushBack(item)
+	return nil
+}
+
+// TakeCtx removes and returns the item at the front of the deque,
+// blocking until one is available or ctx is done.
+func (d *Deque[T]) TakeCtx(ctx context.Context) (T, error) {
+	var item T
+	err := await(ctx, &d.mu, &d.notEmpty, func() (ok bool) {
+		item, ok = d.r.popFront()
+		return ok
+	})
+	return item, err
+}
+
+// PopFront removes and returns the item at the front of the deque.
+// It returns false if the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.r.popFront()
+}
+
+// PopBack removes and returns the item at the back of the deque.
+// It returns false if the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.r.popBack()
+}
+
+// Front returns the item at the front of the deque without removing it.
+// It returns false if the deque is empty.
+func (d *Deque[T]) Front() (T, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.r.front()
+}
+
+// Back returns the item at the back of the deque without removing it.
+// It returns false if the deque is empty.
+func (d *Deque[T]) Back() (T, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.r.back()
+}
+
+// Get returns the i-th item from the front.
+// It returns false if i is out of bounds.
+func (d *Deque[T]) Get(i int) (T, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if i < 0 || i >= d.r.len() {
+		var zero T
+		return zero, false
+	}
+	return d.r.at(i), true
+}
+
+// Len returns the number of items in the deque.
+func (d *Deque[T]) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.r.len()
+}
+
+// Clear removes all items from the deque.
+func (d *Deque[T]) Clear() {
+	d.mu.Lock()
+	d.r.clear()
+	d.mu.Unlock()
+}
+
+// ToSlice returns a copy of the items, from front to back.
+func (d *Deque[T]) ToSlice() []T {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.r.toSlice()
+}
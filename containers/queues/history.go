@@ -0,0 +1,103 @@
+package queues
+
+import "sync"
+
+// History is a thread-safe fixed-size record of the most recent items.
+// Adding to a full History evicts the oldest item, which suits "recent errors"
+// or "recent requests" views that only care about the last N events.
+type History[T any] struct {
+	mu   sync.RWMutex
+	buf  []T
+	next int
+	size int
+}
+
+// NewHistory creates an empty History keeping at most capacity items.
+// A capacity less than 1 is treated as 1.
+func NewHistory[T any](capacity int) *History[T] {
+	return &History[T]{buf: make([]T, max(capacity, 1))}
+}
+
+// Add records items in order, evicting the oldest ones if the History is full.
+func (h *History[T]) Add(items ...T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, item := range items {
+		h.buf[h.next] = item
+		h.next = (h.next + 1) % len(h.buf)
+		h.size = min(h.size+1, len(h.buf))
+	}
+}
+
+// at returns the i-th newest item, with 0 being the most recent.
+func (h *History[T]) at(i int) T {
+	return h.buf[(h.next-1-i+len(h.buf))%len(h.buf)]
+}
+
+// Last returns the most recent item.
+// It returns false if the History is empty.
+func (h *History[T]) Last() (T, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.at(0), true
+}
+
+// Latest returns up to n of the most recent items, newest first.
+func (h *History[T]) Latest(n int) []T {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	n = min(max(n, 0), h.size)
+	items := make([]T, n)
+	for i := range items {
+		items[i] = h.at(i)
+	}
+	return items
+}
+
+// NewestFirst calls f for each item from the most recent to the oldest.
+// If f returns false, iteration stops. f must not modify the History.
+func (h *History[T]) NewestFirst(f func(item T) bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for i := 0; i < h.size; i++ {
+		if !f(h.at(i)) {
+			return
+		}
+	}
+}
+
+// OldestFirst calls f for each item from the oldest to the most recent.
+// If f returns false, iteration stops. f must not modify the History.
+func (h *History[T]) OldestFirst(f func(item T) bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for i := h.size - 1; i >= 0; i-- {
+		if !f(h.at(i)) {
+			return
+		}
+	}
+}
+
+// Len returns the number of items recorded, at most Cap.
+func (h *History[T]) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.size
+}
+
+// Cap returns the maximum number of items kept.
+func (h *History[T]) Cap() int {
+	return len(h.buf)
+}
+
+// Clear removes all items.
+func (h *History[T]) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	clear(h.buf)
+	h.next, h.size = 0, 0
+}
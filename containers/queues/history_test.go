@@ -0,0 +1,45 @@
+package queues
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+	h := NewHistory[int](3)
+	if _, ok := h.Last(); ok {
+		t.Fatal("expected empty history")
+	}
+	h.Add(1, 2, 3, 4, 5)
+	if h.Len() != 3 {
+		t.Fatalf("expected 3 items, got %d", h.Len())
+	}
+	if got := h.Latest(2); !slices.Equal(got, []int{5, 4}) {
+		t.Fatalf("unexpected latest items: %v", got)
+	}
+	if got := h.Latest(10); !slices.Equal(got, []int{5, 4, 3}) {
+		t.Fatalf("unexpected latest items: %v", got)
+	}
+
+	var oldest []int
+	h.OldestFirst(func(item int) bool {
+		oldest = append(oldest, item)
+		return true
+	})
+	if !slices.Equal(oldest, []int{3, 4, 5}) {
+		t.Fatalf("unexpected oldest-first order: %v", oldest)
+	}
+	var newest []int
+	h.NewestFirst(func(item int) bool {
+		newest = append(newest, item)
+		return len(newest) < 2
+	})
+	if !slices.Equal(newest, []int{5, 4}) {
+		t.Fatalf("unexpected newest-first order: %v", newest)
+	}
+
+	h.Clear()
+	if h.Len() != 0 || len(h.Latest(1)) != 0 {
+		t.Fatal("expected empty history after clear")
+	}
+}
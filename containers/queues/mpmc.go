@@ -0,0 +1,130 @@
+package queues
+
+import (
+	"context"
+	"math/bits"
+	"runtime"
+	"sync/atomic"
+)
+
+const cacheLineSize = 64
+
+type mpmcCell[T any] struct {
+	// seq tells producers and consumers whose turn it is to use the cell.
+	seq   atomic.Uint64
+	value T
+}
+
+// MPMCQueue is a bounded lock-free multi-producer multi-consumer FIFO queue,
+// based on Dmitry Vyukov's array queue. Every cell carries a sequence number, so
+// producers and consumers claim cells with a single CAS and never share a lock.
+type MPMCQueue[T any] struct {
+	_     [cacheLineSize]byte
+	head  atomic.Uint64
+	_     [cacheLineSize - 8]byte
+	tail  atomic.Uint64
+	_     [cacheLineSize - 8]byte
+	mask  uint64
+	cells []mpmcCell[T]
+}
+
+// NewMPMCQueue creates an empty MPMCQueue. The capacity is rounded up to a power
+// of two; a capacity less than 2 is treated as 2.
+func NewMPMCQueue[T any](capacity int) *MPMCQueue[T] {
+	n := uint64(1) << bits.Len64(uint64(max(capacity, 2))-1)
+	q := &MPMCQueue[T]{mask: n - 1, cells: make([]mpmcCell[T], n)}
+	for i := range q.cells {
+		q.cells[i].seq.Store(uint64(i))
+	}
+	return q
+}
+
+// TryPut adds item to the back of the queue without blocking.
+// It returns false if the queue is full.
+func (q *MPMCQueue[T]) TryPut(item T) bool {
+	pos := q.tail.Load()
+	for {
+		cell := &q.cells[pos&q.mask]
+		switch seq := cell.seq.Load(); {
+		case seq == pos:
+			if q.tail.CompareAndSwap(pos, pos+1) {
+				cell.value = item
+				cell.seq.Store(pos + 1)
+				return true
+			}
+			pos = q.tail.Load()
+		case seq < pos:
+			// The cell still holds an item from the previous lap.
+			return false
+		default:
+			pos = q.tail.Load()
+		}
+	}
+}
+
+// TryTake removes and returns the item at the front of the queue without blocking.
+// It returns false if the queue is empty.
+func (q *MPMCQueue[T]) TryTake() (T, bool) {
+	pos := q.head.Load()
+	for {
+		cell := &q.cells[pos&q.mask]
+		switch seq := cell.seq.Load(); {
+		case seq == pos+1:
+			if q.head.CompareAndSwap(pos, pos+1) {
+				item := cell.value
+				var zero T
+				cell.value = zero
+				cell.seq.Store(pos + q.mask + 1)
+				return item, true
+			}
+			pos = q.head.Load()
+		case seq < pos+1:
+			// No producer has filled the cell yet.
+			var zero T
+			return zero, false
+		default:
+			pos = q.head.Load()
+		}
+	}
+}
+
+// PutCtx adds item to the back of the queue, spinning until there is room or ctx is done.
+func (q *MPMCQueue[T]) PutCtx(ctx context.Context, item T) error {
+	for !q.TryPut(item) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		runtime.Gosched()
+	}
+	return nil
+}
+
+// TakeCtx removes and returns the item at the front of the queue,
+// spinning until one is available or ctx is done.
+func (q *MPMCQueue[T]) TakeCtx(ctx context.Context) (T, error) {
+	for {
+		if item, ok := q.TryTake(); ok {
+			return item, nil
+		}
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+		runtime.Gosched()
+	}
+}
+
+// Len returns the number of items in the queue. It is only a snapshot while
+// other goroutines are using the queue.
+func (q *MPMCQueue[T]) Len() int {
+	head, tail := q.head.Load(), q.tail.Load()
+	if tail < head {
+		return 0
+	}
+	return int(min(tail-head, q.mask+1))
+}
+
+// Cap returns the capacity of the queue.
+func (q *MPMCQueue[T]) Cap() int {
+	return len(q.cells)
+}
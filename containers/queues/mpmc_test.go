@@ -0,0 +1,91 @@
+package queues
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestMPMCQueue(t *testing.T) {
+	q := NewMPMCQueue[int](3)
+	if q.Cap() != 4 {
+		t.Fatalf("expected capacity to round up to 4, got %d", q.Cap())
+	}
+	for i := 0; i < 4; i++ {
+		if !q.TryPut(i) {
+			t.Fatalf("failed to put %d", i)
+		}
+	}
+	if q.TryPut(4) || q.Len() != 4 {
+		t.Fatalf("expected queue to be full")
+	}
+	for i := 0; i < 4; i++ {
+		if v, ok := q.TryTake(); !ok || v != i {
+			t.Fatalf("expected %d, got %d (ok=%v)", i, v, ok)
+		}
+	}
+	if _, ok := q.TryTake(); ok {
+		t.Fatalf("expected queue to be empty")
+	}
+}
+
+func TestMPMCQueueConcurrent(t *testing.T) {
+	q := NewMPMCQueue[int](16)
+	const producers, consumers, items = 4, 4, 1000
+	ctx := context.Background()
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func() {
+			defer produced.Done()
+			for i := 1; i <= items; i++ {
+				_ = q.PutCtx(ctx, i)
+			}
+		}()
+	}
+	sums := make(chan int, consumers)
+	for c := 0; c < consumers; c++ {
+		go func() {
+			sum := 0
+			for i := 0; i < items; i++ {
+				v, _ := q.TakeCtx(ctx)
+				sum += v
+			}
+			sums <- sum
+		}()
+	}
+	produced.Wait()
+	total := 0
+	for c := 0; c < consumers; c++ {
+		total += <-sums
+	}
+	if want := producers * items * (items + 1) / 2; total != want {
+		t.Fatalf("expected sum %d, got %d", want, total)
+	}
+}
+
+func benchmarkQueue(b *testing.B, put func(int), take func()) {
+	b.SetParallelism(4)
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			put(i)
+			take()
+		}
+	})
+}
+
+func BenchmarkMPMCQueue(b *testing.B) {
+	q := NewMPMCQueue[int](1024)
+	ctx := context.Background()
+	benchmarkQueue(b, func(i int) { _ = q.PutCtx(ctx, i) }, func() { _, _ = q.TakeCtx(ctx) })
+}
+
+func BenchmarkChannel(b *testing.B) {
+	ch := make(chan int, 1024)
+	benchmarkQueue(b, func(i int) { ch <- i }, func() { <-ch })
+}
+
+func BenchmarkBoundedQueue(b *testing.B) {
+	q := NewBoundedQueue[int](1024)
+	benchmarkQueue(b, q.Put, func() { q.Take() })
+}
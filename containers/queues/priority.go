@@ -0,0 +1,217 @@
+package queues
+
+import (
+	"context"
+	"sync"
+)
+
+// Item is a handle to a value stored in a PriorityQueue.
+// It can be passed to Update, Fix and Remove to change or remove the value later.
+type Item[T any] struct {
+	value T
+	index int
+}
+
+// Value returns the value held by the item.
+func (it *Item[T]) Value() T {
+	return it.value
+}
+
+// PriorityQueue is a thread-safe generic binary heap.
+// Pop returns the item for which less reports it is smaller than all others.
+type PriorityQueue[T any] struct {
+	mu       sync.RWMutex
+	less     func(a, b T) bool
+	items    []*Item[T]
+	notEmpty signal
+}
+
+// NewPriorityQueue creates a PriorityQueue ordered by less, with optional initial items.
+func NewPriorityQueue[T any](less func(a, b T) bool, items ...T) *PriorityQueue[T] {
+	pq := &PriorityQueue[T]{less: less, items: make([]*Item[T], len(items))}
+	for i, v := range items {
+		pq.items[i] = &Item[T]{value: v, index: i}
+	}
+	for i := len(pq.items)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+	return pq
+}
+
+// Push adds value to the queue and returns its handle.
+func (pq *PriorityQueue[T]) Push(value T) *Item[T] {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	it := &Item[T]{value: value, index: len(pq.items)}
+	pq.items = append(pq.items, it)
+	pq.up(it.index)
+	pq.notEmpty.broadcast()
+	return it
+}
+
+// PutCtx adds value to the queue. The queue is unbounded, so it only fails if
+// ctx is already done.
+func (pq *PriorityQueue[T]) PutCtx(ctx context.Context, value T) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	pq.Push(value)
+	return nil
+}
+
+// Pop removes and returns the smallest value.
+// It returns false if the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.pop()
+}
+
+func (pq *PriorityQueue[T]) pop() (T, bool) {
+	if len(pq.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.remove(0).value, true
+}
+
+// TakeCtx removes and returns the smallest value,
+// blocking until one is available or ctx is done.
+func (pq *PriorityQueue[T]) TakeCtx(ctx context.Context) (T, error) {
+	var value T
+	err := await(ctx, &pq.mu, &pq.notEmpty, func() (ok bool) {
+		value, ok = pq.pop()
+		return ok
+	})
+	return value, err
+}
+
+// Peek returns the smallest value without removing it.
+// It returns false if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	if len(pq.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Update replaces the value held by it and restores the heap order.
+// It returns false if it is no longer in the queue.
+func (pq *PriorityQueue[T]) Update(it *Item[T], value T) bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	if !pq.contains(it) {
+		return false
+	}
+	it.value = value
+	pq.fix(it.index)
+	return true
+}
+
+// Fix restores the heap order after the priority of the value held by it has changed,
+// for example because it is a pointer whose fields were modified.
+// It returns false if it is no longer in the queue.
+func (pq *PriorityQueue[T]) Fix(it *Item[T]) bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	if !pq.contains(it) {
+		return false
+	}
+	pq.fix(it.index)
+	return true
+}
+
+// Remove removes it from the queue.
+// It returns false if it is no longer in the queue.
+func (pq *PriorityQueue[T]) Remove(it *Item[T]) bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	if !pq.contains(it) {
+		return false
+	}
+	pq.remove(it.index)
+	return true
+}
+
+// Len returns the number of items in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return len(pq.items)
+}
+
+// Clear removes all items from the queue.
+func (pq *PriorityQueue[T]) Clear() {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	for _, it := range pq.items {
+		it.index = -1
+	}
+	clear(pq.items)
+	pq.items = pq.items[:0]
+}
+
+func (pq *PriorityQueue[T]) contains(it *Item[T]) bool {
+	return it != nil && it.index >= 0 && it.index < len(pq.items) && pq.items[it.index] == it
+}
+
+func (pq *PriorityQueue[T]) remove(i int) *Item[T] {
+	n := len(pq.items) - 1
+	if i != n {
+		pq.swap(i, n)
+	}
+	it := pq.items[n]
+	pq.items[n] = nil
+	pq.items = pq.items[:n]
+	if i != n {
+		pq.fix(i)
+	}
+	it.index = -1
+	return it
+}
+
+func (pq *PriorityQueue[T]) fix(i int) {
+	if !pq.down(i) {
+		pq.up(i)
+	}
+}
+
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
+
+func (pq *PriorityQueue[T]) up(j int) {
+	for j > 0 {
+		i := (j - 1) / 2
+		if !pq.less(pq.items[j].value, pq.items[i].value) {
+			break
+		}
+		pq.swap(i, j)
+		j = i
+	}
+}
+
+// down moves the item at i0 towards the leaves and reports whether it moved.
+func (pq *PriorityQueue[T]) down(i0 int) bool {
+	i, n := i0, len(pq.items)
+	for {
+		j := 2*i + 1
+		if j >= n {
+			break
+		}
+		if r := j + 1; r < n && pq.less(pq.items[r].value, pq.items[j].value) {
+			j = r
+		}
+		if !pq.less(pq.items[j].value, pq.items[i].value) {
+			break
+		}
+		pq.swap(i, j)
+		i = j
+	}
+	return i > i0
+}
@@ -0,0 +1,89 @@
+package queues
+
+import (
+	"context"
+	"sync"
+)
+
+// Queue is a thread-safe generic FIFO queue.
+// It is backed by a growable ring buffer, so Enqueue and Dequeue are amortized O(1).
+type Queue[T any] struct {
+	mu       sync.RWMutex
+	r        ring[T]
+	notEmpty signal
+}
+
+// New creates a Queue with optional initial items, the first at the front.
+func New[T any](items ...T) *Queue[T] {
+	q := &Queue[T]{}
+	q.Enqueue(items...)
+	return q
+}
+
+// Enqueue adds items to the back of the queue.
+func (q *Queue[T]) Enqueue(items ...T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, item := range items {
+		q.r.pushBack(item)
+	}
+	q.notEmpty.broadcast()
+}
+
+// PutCtx adds item to the back of the queue. The queue is unbounded, so it only
+// fails if ctx is already done.
+func (q *Queue[T]) PutCtx(ctx context.Context, item T) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	q.Enqueue(item)
+	return nil
+}
+
+// Dequeue removes and returns the item at the front of the queue.
+// It returns false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.r.popFront()
+}
+
+// TakeCtx removes and returns the item at the front of the queue,
+// blocking until one is available or ctx is done.
+func (q *Queue[T]) TakeCtx(ctx context.Context) (T, error) {
+	var item T
+	err := await(ctx, &q.mu, &q.notEmpty, func() (ok bool) {
+		item, ok = q.r.popFront()
+		return ok
+	})
+	return item, err
+}
+
+// Peek returns the item at the front of the queue without removing it.
+// It returns false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.r.front()
+}
+
+// Len returns the number of items in the queue.
+func (q *Queue[T]) Len() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.r.len()
+}
+
+// Clear removes all items from the queue.
+func (q *Queue[T]) Clear() {
+	q.mu.Lock()
+	q.r.clear()
+	q.mu.Unlock()
+}
+
+// ToSlice returns a copy of the items, from front to back.
+func (q *Queue[T]) ToSlice() []T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.r.toSlice()
+}
@@ -0,0 +1,101 @@
+package queues
+
+import "testing"
+
+func TestQueueWrapsAndGrows(t *testing.T) {
+	q := New[int]()
+	next := 0
+	for round := range 5 {
+		for i := 0; i < 7+round*3; i++ {
+			q.Enqueue(next + i)
+		}
+		for range 5 {
+			v, ok := q.Dequeue()
+			if !ok || v != next {
+				t.Fatalf("expected %d, got %d (ok=%v)", next, v, ok)
+			}
+			next++
+		}
+		// Re-sync the producer with what is already queued.
+		items := q.ToSlice()
+		if len(items) > 0 && items[0] != next {
+			t.Fatalf("expected front %d, got %d", next, items[0])
+		}
+		for _, v := range items {
+			if got, _ := q.Dequeue(); got != v {
+				t.Fatalf("expected %d, got %d", v, got)
+			}
+		}
+		next = items[len(items)-1] + 1
+	}
+	if _, ok := q.Dequeue(); ok {
+		t.Fatalf("expected empty queue")
+	}
+}
+
+func TestDequeBothEnds(t *testing.T) {
+	d := NewDeque(3, 4)
+	d.PushFront(1, 2)
+	d.PushBack(5)
+
+	want := []int{1, 2, 3, 4, 5}
+	got := d.ToSlice()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+	if v, _ := d.PopBack(); v != 5 {
+		t.Fatalf("expected 5 from the back, got %d", v)
+	}
+	if v, _ := d.PopFront(); v != 1 {
+		t.Fatalf("expected 1 from the front, got %d", v)
+	}
+	if v, _ := d.Get(1); v != 3 {
+		t.Fatalf("expected 3 at index 1, got %d", v)
+	}
+}
+
+func TestPriorityQueueUpdate(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b }, 5, 3, 8)
+	it := pq.Push(7)
+	pq.Push(1)
+
+	if !pq.Update(it, 0) {
+		t.Fatalf("expected update to succeed")
+	}
+	var got []int
+	for pq.Len() > 0 {
+		v, _ := pq.Pop()
+		got = append(got, v)
+	}
+	want := []int{0, 1, 3, 5, 8}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+	if pq.Update(it, 2) {
+		t.Fatalf("expected update of a popped item to fail")
+	}
+}
+
+func TestRingBufferPolicies(t *testing.T) {
+	b := NewRingBuffer[int](3, Overwrite)
+	for i := 1; i <= 5; i++ {
+		b.Push(i)
+	}
+	if got := b.ToSlice(); len(got) != 3 || got[0] != 3 || got[2] != 5 {
+		t.Fatalf("expected [3 4 5], got %v", got)
+	}
+
+	r := NewRingBuffer[int](2, Reject)
+	r.Push(1)
+	r.Push(2)
+	if r.Push(3) {
+		t.Fatalf("expected push into a full buffer to be rejected")
+	}
+	if v, _ := r.Pop(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+}
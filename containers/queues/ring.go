@@ -0,0 +1,98 @@
+package queues
+
+// ring is a growable circular buffer. It is not safe for concurrent use;
+// the exported queue types guard it with their own locks.
+type ring[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+func (r *ring[T]) len() int {
+	return r.size
+}
+
+// grow doubles the buffer, unwrapping the items to the start of the new one.
+func (r *ring[T]) grow() {
+	buf := make([]T, max(2*len(r.buf), 8))
+	n := copy(buf, r.buf[r.head:])
+	copy(buf[n:], r.buf[:r.head])
+	r.buf = buf
+	r.head = 0
+}
+
+func (r *ring[T]) pushBack(item T) {
+	if r.size == len(r.buf) {
+		r.grow()
+	}
+	r.buf[(r.head+r.size)%len(r.buf)] = item
+	r.size++
+}
+
+func (r *ring[T]) popFront() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	item := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return item, true
+}
+
+func (r *ring[T]) pushFront(item T) {
+	if r.size == len(r.buf) {
+		r.grow()
+	}
+	r.head = (r.head - 1 + len(r.buf)) % len(r.buf)
+	r.buf[r.head] = item
+	r.size++
+}
+
+func (r *ring[T]) popBack() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	i := (r.head + r.size - 1) % len(r.buf)
+	item := r.buf[i]
+	r.buf[i] = zero
+	r.size--
+	return item, true
+}
+
+func (r *ring[T]) back() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.at(r.size - 1), true
+}
+
+func (r *ring[T]) front() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.head], true
+}
+
+// at returns the i-th item from the front. The caller must check bounds.
+func (r *ring[T]) at(i int) T {
+	return r.buf[(r.head+i)%len(r.buf)]
+}
+
+func (r *ring[T]) clear() {
+	clear(r.buf)
+	r.head = 0
+	r.size = 0
+}
+
+func (r *ring[T]) toSlice() []T {
+	items := make([]T, r.size)
+	for i := range items {
+		items[i] = r.at(i)
+	}
+	return items
+}
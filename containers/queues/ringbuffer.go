@@ -0,0 +1,151 @@
+package queues
+
+import (
+	"context"
+	"sync"
+)
+
+// OverflowPolicy decides what a RingBuffer does when an item is pushed while it is full.
+type OverflowPolicy int
+
+const (
+	// Overwrite drops the oldest item to make room for the new one.
+	Overwrite OverflowPolicy = iota
+	// Reject keeps the buffer unchanged and rejects the new item.
+	Reject
+)
+
+// RingBuffer is a thread-safe generic FIFO buffer with a fixed capacity.
+type RingBuffer[T any] struct {
+	mu       sync.RWMutex
+	buf      []T
+	head     int
+	size     int
+	policy   OverflowPolicy
+	notEmpty signal
+	notFull  signal
+}
+
+// NewRingBuffer creates an empty RingBuffer holding at most capacity items.
+// A capacity less than 1 is treated as 1.
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	return &RingBuffer[T]{buf: make([]T, max(capacity, 1)), policy: policy}
+}
+
+// Push adds item to the buffer.
+// It returns false if the buffer is full and the policy is Reject.
+func (b *RingBuffer[T]) Push(item T) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.push(item)
+}
+
+func (b *RingBuffer[T]) push(item T) bool {
+	if b.size == len(b.buf) {
+		if b.policy == Reject {
+			return false
+		}
+		b.buf[b.head] = item
+		b.head = (b.head + 1) % len(b.buf)
+		return true
+	}
+	b.buf[(b.head+b.size)%len(b.buf)] = item
+	b.size++
+	b.notEmpty.broadcast()
+	return true
+}
+
+// PutCtx adds item to the buffer. With the Reject policy it blocks while the buffer
+// is full until there is room or ctx is done; with Overwrite it never blocks.
+func (b *RingBuffer[T]) PutCtx(ctx context.Context, item T) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return await(ctx, &b.mu, &b.notFull, func() bool {
+		return b.push(item)
+	})
+}
+
+// Pop removes and returns the oldest item.
+// It returns false if the buffer is empty.
+func (b *RingBuffer[T]) Pop() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.pop()
+}
+
+func (b *RingBuffer[T]) pop() (T, bool) {
+	var zero T
+	if b.size == 0 {
+		return zero, false
+	}
+	item := b.buf[b.head]
+	b.buf[b.head] = zero
+	b.head = (b.head + 1) % len(b.buf)
+	b.size--
+	b.notFull.broadcast()
+	return item, true
+}
+
+// TakeCtx removes and returns the oldest item,
+// blocking until one is available or ctx is done.
+func (b *RingBuffer[T]) TakeCtx(ctx context.Context) (T, error) {
+	var item T
+	err := await(ctx, &b.mu, &b.notEmpty, func() (ok bool) {
+		item, ok = b.pop()
+		return ok
+	})
+	return item, err
+}
+
+// Peek returns the oldest item without removing it.
+// It returns false if the buffer is empty.
+func (b *RingBuffer[T]) Peek() (T, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return b.buf[b.head], true
+}
+
+// Len returns the number of items in the buffer.
+func (b *RingBuffer[T]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.size
+}
+
+// Cap returns the capacity of the buffer.
+func (b *RingBuffer[T]) Cap() int {
+	return len(b.buf)
+}
+
+// Full reports whether the buffer holds Cap items.
+func (b *RingBuffer[T]) Full() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.size == len(b.buf)
+}
+
+// Clear removes all items from the buffer.
+func (b *RingBuffer[T]) Clear() {
+	b.mu.Lock()
+	clear(b.buf)
+	b.head = 0
+	b.size = 0
+	b.notFull.broadcast()
+	b.mu.Unlock()
+}
+
+// ToSlice returns a copy of the items, from oldest to newest.
+func (b *RingBuffer[T]) ToSlice() []T {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	items := make([]T, b.size)
+	for i := range items {
+		items[i] = b.buf[(b.head+i)%len(b.buf)]
+	}
+	return items
+}
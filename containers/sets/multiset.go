@@ -0,0 +1,127 @@
+package sets
+
+import "sync"
+
+// MultiSet is a thread-safe bag that counts how many times each item was added.
+// The zero value is an empty set ready to use.
+type MultiSet[T comparable] struct {
+	mu     sync.RWMutex
+	counts map[T]int
+	total  int
+}
+
+// NewMultiSet creates a MultiSet from the given items, counting duplicates.
+func NewMultiSet[T comparable](items ...T) *MultiSet[T] {
+	m := &MultiSet[T]{counts: make(map[T]int, len(items))}
+	m.Add(items...)
+	return m
+}
+
+// Add adds one occurrence of each item.
+func (m *MultiSet[T]) Add(items ...T) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lazyInit()
+	for _, item := range items {
+		m.counts[item]++
+	}
+	m.total += len(items)
+}
+
+// AddN adds n occurrences of item. n less than 1 is a no-op.
+func (m *MultiSet[T]) AddN(item T, n int) {
+	if n < 1 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lazyInit()
+	m.counts[item] += n
+	m.total += n
+}
+
+func (m *MultiSet[T]) lazyInit() {
+	if m.counts == nil {
+		m.counts = make(map[T]int)
+	}
+}
+
+// Remove removes one occurrence of item and reports whether it was present.
+func (m *MultiSet[T]) Remove(item T) bool {
+	return m.RemoveN(item, 1) > 0
+}
+
+// RemoveN removes up to n occurrences of item and returns how many were removed.
+func (m *MultiSet[T]) RemoveN(item T, n int) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := m.counts[item]
+	n = min(max(n, 0), count)
+	if n == count {
+		delete(m.counts, item)
+	} else {
+		m.counts[item] = count - n
+	}
+	m.total -= n
+	return n
+}
+
+// RemoveAll removes every occurrence of item and returns how many were removed.
+func (m *MultiSet[T]) RemoveAll(item T) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := m.counts[item]
+	delete(m.counts, item)
+	m.total -= n
+	return n
+}
+
+// Count returns the number of occurrences of item.
+func (m *MultiSet[T]) Count(item T) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.counts[item]
+}
+
+// Has reports whether item occurs at least once.
+func (m *MultiSet[T]) Has(item T) bool {
+	return m.Count(item) > 0
+}
+
+// Len returns the total number of occurrences of all items.
+func (m *MultiSet[T]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.total
+}
+
+// Distinct returns the unique items in unspecified order.
+func (m *MultiSet[T]) Distinct() []T {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	items := make([]T, 0, len(m.counts))
+	for item := range m.counts {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Range calls f for every unique item and its count in unspecified order.
+// If f returns false, iteration stops. f must not modify the set.
+func (m *MultiSet[T]) Range(f func(item T, count int) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for item, count := range m.counts {
+		if !f(item, count) {
+			return
+		}
+	}
+}
+
+// Clear removes all items from the set.
+func (m *MultiSet[T]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	clear(m.counts)
+	m.total = 0
+}
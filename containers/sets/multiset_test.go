@@ -0,0 +1,51 @@
+package sets
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMultiSet(t *testing.T) {
+	m := NewMultiSet("a", "b", "a")
+	m.AddN("c", 3)
+	if m.Count("a") != 2 || m.Count("c") != 3 || m.Count("missing") != 0 {
+		t.Fatalf("unexpected counts: a=%d c=%d", m.Count("a"), m.Count("c"))
+	}
+	if m.Len() != 6 {
+		t.Fatalf("expected 6 occurrences, got %d", m.Len())
+	}
+	if !m.Remove("a") || m.Count("a") != 1 {
+		t.Fatalf("expected Remove to decrement a")
+	}
+	if n := m.RemoveN("c", 10); n != 3 || m.Has("c") {
+		t.Fatalf("expected RemoveN to remove all 3 c, got %d", n)
+	}
+	if m.Remove("c") {
+		t.Fatalf("expected removing an absent item to report false")
+	}
+	distinct := m.Distinct()
+	slices.Sort(distinct)
+	if !slices.Equal(distinct, []string{"a", "b"}) {
+		t.Fatalf("unexpected distinct items: %v", distinct)
+	}
+	if m.Len() != 2 {
+		t.Fatalf("expected 2 occurrences, got %d", m.Len())
+	}
+}
+
+func TestMultiSetZeroValue(t *testing.T) {
+	var m MultiSet[string]
+	if m.Remove("a") || m.RemoveAll("a") != 0 || m.Len() != 0 {
+		t.Fatal("expected the zero value to be empty")
+	}
+	m.Add("a", "b", "a")
+	m.AddN("c", 2)
+	if m.Count("a") != 2 || m.Count("c") != 2 || m.Len() != 5 {
+		t.Fatalf("unexpected counts: a=%d c=%d len=%d", m.Count("a"), m.Count("c"), m.Len())
+	}
+	var n MultiSet[int]
+	n.AddN(1, 3)
+	if n.Count(1) != 3 {
+		t.Fatalf("expected AddN to work on the zero value, got %d", n.Count(1))
+	}
+}
@@ -0,0 +1,88 @@
+package sets
+
+import (
+	"encoding/json"
+
+	"github.com/go-kratos/kit/containers/maps"
+)
+
+// OrderedSet is a thread-safe set that iterates in the order items were first inserted.
+// Re-inserting an item keeps its original position.
+type OrderedSet[T comparable] struct {
+	m *maps.OrderedMap[T, Empty]
+}
+
+// NewOrdered creates an OrderedSet from the given items, keeping the first occurrence of each.
+func NewOrdered[T comparable](items ...T) *OrderedSet[T] {
+	set := &OrderedSet[T]{m: maps.NewOrderedMap[T, Empty]()}
+	set.Insert(items...)
+	return set
+}
+
+// Insert adds items to the set.
+func (s *OrderedSet[T]) Insert(items ...T) *OrderedSet[T] {
+	for _, item := range items {
+		s.m.Set(item, Empty{})
+	}
+	return s
+}
+
+// Delete removes items from the set.
+func (s *OrderedSet[T]) Delete(items ...T) *OrderedSet[T] {
+	for _, item := range items {
+		s.m.Delete(item)
+	}
+	return s
+}
+
+// Clear removes all items from the set.
+func (s *OrderedSet[T]) Clear() *OrderedSet[T] {
+	s.m.Clear()
+	return s
+}
+
+// Has checks if the set contains the given item.
+func (s *OrderedSet[T]) Has(item T) bool {
+	return s.m.Has(item)
+}
+
+// Len returns the number of items in the set.
+func (s *OrderedSet[T]) Len() int {
+	return s.m.Len()
+}
+
+// Range calls f for each item in insertion order. If f returns false, iteration stops.
+func (s *OrderedSet[T]) Range(f func(item T) bool) {
+	s.m.Range(func(item T, _ Empty) bool {
+		return f(item)
+	})
+}
+
+// ToSlice returns the items in insertion order.
+func (s *OrderedSet[T]) ToSlice() []T {
+	return s.m.Keys()
+}
+
+// Clone creates a copy of the set with the same order.
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	return NewOrdered(s.ToSlice()...)
+}
+
+// MarshalJSON marshals the set into a JSON array in insertion order.
+func (s *OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the set, keeping the array order.
+func (s *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if s.m == nil {
+		s.m = maps.NewOrderedMap[T, Empty]()
+	}
+	s.Clear()
+	s.Insert(items...)
+	return nil
+}
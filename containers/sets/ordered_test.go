@@ -0,0 +1,30 @@
+package sets
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestOrderedSet(t *testing.T) {
+	s := NewOrdered("c", "a", "c", "b", "a")
+	if got := s.ToSlice(); !slices.Equal(got, []string{"c", "a", "b"}) {
+		t.Fatalf("expected first-seen order, got %v", got)
+	}
+	s.Delete("a").Insert("a")
+	if got := s.ToSlice(); !slices.Equal(got, []string{"c", "b", "a"}) {
+		t.Fatalf("expected re-added item at the back, got %v", got)
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `["c","b","a"]` {
+		t.Fatalf("unexpected JSON: %s, %v", data, err)
+	}
+	var decoded OrderedSet[string]
+	if err := json.Unmarshal([]byte(`["z","x","z"]`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded.ToSlice(); !slices.Equal(got, []string{"z", "x"}) {
+		t.Fatalf("unexpected decoded items: %v", got)
+	}
+}
@@ -90,6 +90,67 @@ func (s *Set[T]) Clone() *Set[T] {
 }
 
 // Len returns the number of items in the set.
+func (s *Set[T]) Len() int {
+	n := 0
+	s.m.Range(func(T, Empty) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// Range calls f for each item in the set. If f returns false, iteration stops.
+func (s *Set[T]) Range(f func(item T) bool) {
+	s.m.Range(func(item T, _ Empty) bool {
+		return f(item)
+	})
+}
+
+// Union returns a new set with the items in either s or other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	set := s.Clone()
+	other.Range(func(item T) bool {
+		set.Insert(item)
+		return true
+	})
+	return set
+}
+
+// Intersect returns a new set with the items in both s and other.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	set := New[T]()
+	s.Range(func(item T) bool {
+		if other.Has(item) {
+			set.Insert(item)
+		}
+		return true
+	})
+	return set
+}
+
+// Difference returns a new set with the items in s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	set := New[T]()
+	s.Range(func(item T) bool {
+		if !other.Has(item) {
+			set.Insert(item)
+		}
+		return true
+	})
+	return set
+}
+
+// IsSubset reports whether every item in s is also in other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	subset := true
+	s.Range(func(item T) bool {
+		subset = other.Has(item)
+		return subset
+	})
+	return subset
+}
+
+// MarshalJSON marshals the set into a JSON array.
 func (s *Set[T]) MarshalJSON() ([]byte, error) {
 	items := make([]T, 0)
 	s.m.Range(func(item T, _ Empty) bool {
@@ -0,0 +1,36 @@
+package sets
+
+import (
+	"slices"
+	"testing"
+)
+
+func sorted(s *Set[int]) []int {
+	items := s.ToSlice()
+	slices.Sort(items)
+	return items
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+
+	if got := sorted(a.Union(b)); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("unexpected union: %v", got)
+	}
+	if got := sorted(a.Intersect(b)); !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("unexpected intersection: %v", got)
+	}
+	if got := sorted(a.Difference(b)); !slices.Equal(got, []int{1}) {
+		t.Fatalf("unexpected difference: %v", got)
+	}
+	if a.IsSubset(b) {
+		t.Fatalf("expected %v to not be a subset of %v", sorted(a), sorted(b))
+	}
+	if !New(2, 3).IsSubset(a) {
+		t.Fatalf("expected [2 3] to be a subset of %v", sorted(a))
+	}
+	if a.Len() != 3 {
+		t.Fatalf("expected length 3, got %d", a.Len())
+	}
+}
@@ -0,0 +1,312 @@
+package sets
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sync"
+)
+
+const (
+	sortedMaxLevel = 32
+	sortedP        = 0.25
+)
+
+type sortedNode[T any] struct {
+	item T
+	next []*sortedNode[T]
+	// span[i] is the number of positions between this node and next[i].
+	span []int
+}
+
+// SortedSet is a thread-safe set that keeps its items ordered by less.
+// It is backed by a skip list, so insertion, removal, lookups and rank queries are O(log n).
+type SortedSet[T any] struct {
+	mu     sync.RWMutex
+	less   func(a, b T) bool
+	head   *sortedNode[T]
+	level  int
+	length int
+}
+
+// NewSorted creates a SortedSet ordered by less from the given items.
+func NewSorted[T any](less func(a, b T) bool, items ...T) *SortedSet[T] {
+	s := &SortedSet[T]{less: less}
+	s.reset()
+	s.Insert(items...)
+	return s
+}
+
+func (s *SortedSet[T]) reset() {
+	s.head = &sortedNode[T]{
+		next: make([]*sortedNode[T], sortedMaxLevel),
+		span: make([]int, sortedMaxLevel),
+	}
+	s.level = 1
+	s.length = 0
+}
+
+func randomSortedLevel() int {
+	level := 1
+	for level < sortedMaxLevel && rand.Float64() < sortedP {
+		level++
+	}
+	return level
+}
+
+// lowerBound returns the first node not less than item, or nil.
+// The caller must hold the lock.
+func (s *SortedSet[T]) lowerBound(item T) *sortedNode[T] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.less(x.next[i].item, item) {
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
+
+func (s *SortedSet[T]) insert(item T) bool {
+	var (
+		update [sortedMaxLevel]*sortedNode[T]
+		rank   [sortedMaxLevel]int
+	)
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && s.less(x.next[i].item, item) {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	if n := x.next[0]; n != nil && !s.less(item, n.item) {
+		return false
+	}
+	level := randomSortedLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			s.head.span[i] = s.length
+		}
+		s.level = level
+	}
+	n := &sortedNode[T]{
+		item: item,
+		next: make([]*sortedNode[T], level),
+		span: make([]int, level),
+	}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.length++
+	return true
+}
+
+func (s *SortedSet[T]) delete(item T) bool {
+	var update [sortedMaxLevel]*sortedNode[T]
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.less(x.next[i].item, item) {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	target := x.next[0]
+	if target == nil || s.less(item, target.item) {
+		return false
+	}
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == target {
+			update[i].span[i] += target.span[i] - 1
+			update[i].next[i] = target.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.length--
+	return true
+}
+
+// Insert adds items to the set.
+func (s *SortedSet[T]) Insert(items ...T) *SortedSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, item := range items {
+		s.insert(item)
+	}
+	return s
+}
+
+// Delete removes items from the set.
+func (s *SortedSet[T]) Delete(items ...T) *SortedSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, item := range items {
+		s.delete(item)
+	}
+	return s
+}
+
+// Clear removes all items from the set.
+func (s *SortedSet[T]) Clear() *SortedSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset()
+	return s
+}
+
+// Has checks if the set contains the given item.
+func (s *SortedSet[T]) Has(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n := s.lowerBound(item)
+	return n != nil && !s.less(item, n.item)
+}
+
+// Len returns the number of items in the set.
+func (s *SortedSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.length
+}
+
+// Min returns the smallest item in the set.
+// It returns false if the set is empty.
+func (s *SortedSet[T]) Min() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if n := s.head.next[0]; n != nil {
+		return n.item, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Max returns the largest item in the set.
+// It returns false if the set is empty.
+func (s *SortedSet[T]) Max() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			x = x.next[i]
+		}
+	}
+	if x == s.head {
+		var zero T
+		return zero, false
+	}
+	return x.item, true
+}
+
+// Rank returns the 0-based position of item in the set, or -1 if it is not present.
+func (s *SortedSet[T]) Rank(item T) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rank := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && !s.less(item, x.next[i].item) {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+	if x == s.head || s.less(x.item, item) {
+		return -1
+	}
+	return rank - 1
+}
+
+// At returns the item at the 0-based position rank.
+// It returns false if rank is out of bounds.
+func (s *SortedSet[T]) At(rank int) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var zero T
+	if rank < 0 || rank >= s.length {
+		return zero, false
+	}
+	target, traversed := rank+1, 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= target {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == target {
+			return x.item, true
+		}
+	}
+	return zero, false
+}
+
+// Range calls f for each item of a snapshot of the set in ascending order.
+// If f returns false, iteration stops.
+func (s *SortedSet[T]) Range(f func(item T) bool) {
+	for _, item := range s.ToSlice() {
+		if !f(item) {
+			break
+		}
+	}
+}
+
+// RangeBetween calls f in ascending order for each item of a snapshot of the set
+// within the inclusive bounds [from, to]. If f returns false, iteration stops.
+func (s *SortedSet[T]) RangeBetween(from, to T, f func(item T) bool) {
+	s.mu.RLock()
+	items := make([]T, 0)
+	for n := s.lowerBound(from); n != nil && !s.less(to, n.item); n = n.next[0] {
+		items = append(items, n.item)
+	}
+	s.mu.RUnlock()
+	for _, item := range items {
+		if !f(item) {
+			break
+		}
+	}
+}
+
+// ToSlice returns the items in the set as a slice in ascending order.
+func (s *SortedSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items := make([]T, 0, s.length)
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		items = append(items, n.item)
+	}
+	return items
+}
+
+// MarshalJSON marshals the set into a JSON array in ascending order.
+func (s *SortedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the set. The set must have been
+// created with NewSorted, since a zero SortedSet has no ordering to insert with.
+func (s *SortedSet[T]) UnmarshalJSON(data []byte) error {
+	if s.less == nil {
+		return errors.New("sets: cannot unmarshal into a SortedSet not created with NewSorted")
+	}
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	s.Clear()
+	s.Insert(items...)
+	return nil
+}
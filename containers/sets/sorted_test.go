@@ -0,0 +1,77 @@
+package sets
+
+import (
+	"cmp"
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestSortedSetMatchesSortedSlice(t *testing.T) {
+	s := NewSorted(cmp.Less[int])
+	want := make(map[int]struct{})
+	r := rand.New(rand.NewSource(1))
+	for range 2000 {
+		v := r.Intn(200)
+		if r.Intn(3) == 0 {
+			s.Delete(v)
+			delete(want, v)
+		} else {
+			s.Insert(v)
+			want[v] = struct{}{}
+		}
+	}
+
+	expected := make([]int, 0, len(want))
+	for v := range want {
+		expected = append(expected, v)
+	}
+	slices.Sort(expected)
+
+	if got := s.ToSlice(); !slices.Equal(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i, v := range expected {
+		if rank := s.Rank(v); rank != i {
+			t.Fatalf("expected rank %d for %d, got %d", i, v, rank)
+		}
+		if got, ok := s.At(i); !ok || got != v {
+			t.Fatalf("expected %d at rank %d, got %d", v, i, got)
+		}
+	}
+	if min, _ := s.Min(); min != expected[0] {
+		t.Fatalf("expected min %d, got %d", expected[0], min)
+	}
+	if max, _ := s.Max(); max != expected[len(expected)-1] {
+		t.Fatalf("expected max %d, got %d", expected[len(expected)-1], max)
+	}
+
+	var between []int
+	s.RangeBetween(50, 100, func(v int) bool {
+		between = append(between, v)
+		return true
+	})
+	lo, _ := slices.BinarySearch(expected, 50)
+	hi, found := slices.BinarySearch(expected, 100)
+	if found {
+		hi++
+	}
+	if !slices.Equal(between, expected[lo:hi]) {
+		t.Fatalf("expected %v, got %v", expected[lo:hi], between)
+	}
+}
+
+func TestSortedSetUnmarshalJSON(t *testing.T) {
+	s := NewSorted(func(a, b int) bool { return a < b })
+	if err := json.Unmarshal([]byte(`[3,1,2]`), s); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+	var v struct{ S SortedSet[int] }
+	if err := json.Unmarshal([]byte(`{"S":[1]}`), &v); err == nil {
+		t.Fatal("expected an error for a zero SortedSet")
+	}
+}
@@ -0,0 +1,132 @@
+package sets
+
+import "sync"
+
+// UnionFind is a thread-safe disjoint-set forest. It uses path compression and
+// union by rank, so operations run in nearly constant amortized time.
+type UnionFind[T comparable] struct {
+	mu     sync.Mutex
+	index  map[T]int
+	items  []T
+	parent []int
+	rank   []uint8
+	groups int
+}
+
+// NewUnionFind creates a UnionFind with each of items in its own group.
+func NewUnionFind[T comparable](items ...T) *UnionFind[T] {
+	u := &UnionFind[T]{index: make(map[T]int, len(items))}
+	for _, item := range items {
+		u.add(item)
+	}
+	return u
+}
+
+// add returns the index of item, adding it as its own group if needed.
+// The caller must hold the lock.
+func (u *UnionFind[T]) add(item T) int {
+	if i, ok := u.index[item]; ok {
+		return i
+	}
+	i := len(u.items)
+	u.index[item] = i
+	u.items = append(u.items, item)
+	u.parent = append(u.parent, i)
+	u.rank = append(u.rank, 0)
+	u.groups++
+	return i
+}
+
+func (u *UnionFind[T]) root(i int) int {
+	for u.parent[i] != i {
+		u.parent[i] = u.parent[u.parent[i]]
+		i = u.parent[i]
+	}
+	return i
+}
+
+// Add adds item as its own group if it is not already present.
+func (u *UnionFind[T]) Add(item T) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.add(item)
+}
+
+// Find returns the representative of item's group.
+// It returns false if item has not been added.
+func (u *UnionFind[T]) Find(item T) (T, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	i, ok := u.index[item]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return u.items[u.root(i)], true
+}
+
+// Union merges the groups of a and b, adding them if needed.
+// It reports whether two different groups were merged.
+func (u *UnionFind[T]) Union(a, b T) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	ra, rb := u.root(u.add(a)), u.root(u.add(b))
+	if ra == rb {
+		return false
+	}
+	if u.rank[ra] < u.rank[rb] {
+		ra, rb = rb, ra
+	}
+	u.parent[rb] = ra
+	if u.rank[ra] == u.rank[rb] {
+		u.rank[ra]++
+	}
+	u.groups--
+	return true
+}
+
+// Connected reports whether a and b are in the same group.
+func (u *UnionFind[T]) Connected(a, b T) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	ia, ok := u.index[a]
+	if !ok {
+		return false
+	}
+	ib, ok := u.index[b]
+	return ok && u.root(ia) == u.root(ib)
+}
+
+// Len returns the number of items.
+func (u *UnionFind[T]) Len() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return len(u.items)
+}
+
+// Count returns the number of groups.
+func (u *UnionFind[T]) Count() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.groups
+}
+
+// Groups returns the items partitioned by group. Groups are ordered by their
+// first added item, and items within a group by insertion order.
+func (u *UnionFind[T]) Groups() [][]T {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	pos := make(map[int]int, u.groups)
+	groups := make([][]T, 0, u.groups)
+	for i, item := range u.items {
+		r := u.root(i)
+		g, ok := pos[r]
+		if !ok {
+			g = len(groups)
+			pos[r] = g
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], item)
+	}
+	return groups
+}
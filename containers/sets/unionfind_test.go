@@ -0,0 +1,36 @@
+package sets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	u := NewUnionFind("a", "b", "c", "d", "e")
+	u.Union("a", "c")
+	u.Union("d", "e")
+	if !u.Union("c", "d") {
+		t.Fatalf("expected merging two groups to report true")
+	}
+	if u.Union("a", "e") {
+		t.Fatalf("expected merging a group with itself to report false")
+	}
+	if !u.Connected("a", "e") || u.Connected("a", "b") || u.Connected("a", "missing") {
+		t.Fatalf("unexpected Connected results")
+	}
+	ra, _ := u.Find("a")
+	re, _ := u.Find("e")
+	if ra != re {
+		t.Fatalf("expected a and e to share a representative, got %q and %q", ra, re)
+	}
+	if _, ok := u.Find("missing"); ok {
+		t.Fatalf("expected missing item to not be found")
+	}
+	if u.Count() != 2 || u.Len() != 5 {
+		t.Fatalf("expected 2 groups of 5 items, got %d groups of %d", u.Count(), u.Len())
+	}
+	want := [][]string{{"a", "c", "d", "e"}, {"b"}}
+	if got := u.Groups(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected groups %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,159 @@
+package sketches
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+	"sync"
+)
+
+var (
+	// ErrIncompatible is returned when merging sketches with different parameters.
+	ErrIncompatible = errors.New("sketches: incompatible parameters")
+	// ErrInvalidData is returned when unmarshaling malformed binary data.
+	ErrInvalidData = errors.New("sketches: invalid binary data")
+)
+
+// bloomMaxHashes bounds the number of hash functions, which only grows with
+// vanishingly small false-positive rates and is otherwise a decoding hazard.
+const bloomMaxHashes = 1 << 10
+
+// BloomFilter is a thread-safe probabilistic set. MayContain never reports false
+// for an added item, and reports true for an absent item with about the
+// configured false-positive rate.
+type BloomFilter[T any] struct {
+	mu    sync.RWMutex
+	words []uint64
+	m     uint64
+	k     uint32
+}
+
+// NewBloom creates a BloomFilter sized for n items at the false-positive rate fpRate.
+// n less than 1 is treated as 1, and an fpRate outside (0, 1) defaults to 0.01.
+func NewBloom[T any](n int, fpRate float64) *BloomFilter[T] {
+	n = max(n, 1)
+	if fpRate <= 0 || fpRate >= 1 {
+		fpRate = 0.01
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	m = max(m, 64)
+	k := uint32(math.Round(float64(m) / float64(n) * math.Ln2))
+	return newBloom[T](m, min(max(k, 1), bloomMaxHashes))
+}
+
+func newBloom[T any](m uint64, k uint32) *BloomFilter[T] {
+	return &BloomFilter[T]{words: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+// locations calls f with each of the k bit positions for item, using double hashing.
+func (b *BloomFilter[T]) locations(item T, f func(i uint64) bool) {
+	h1 := hash64(item)
+	h2 := mix64(h1) | 1
+	for i := uint32(0); i < b.k; i++ {
+		if !f((h1 + uint64(i)*h2) % b.m) {
+			return
+		}
+	}
+}
+
+// Add adds item to the filter.
+func (b *BloomFilter[T]) Add(item T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.locations(item, func(i uint64) bool {
+		b.words[i/64] |= 1 << (i % 64)
+		return true
+	})
+}
+
+// MayContain reports whether item may have been added.
+// A false result means item was definitely never added.
+func (b *BloomFilter[T]) MayContain(item T) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	found := true
+	b.locations(item, func(i uint64) bool {
+		found = b.words[i/64]&(1<<(i%64)) != 0
+		return found
+	})
+	return found
+}
+
+// Merge adds every item of other to b. Both filters must have been created with
+// the same parameters, otherwise ErrIncompatible is returned.
+func (b *BloomFilter[T]) Merge(other *BloomFilter[T]) error {
+	if b == other {
+		return nil
+	}
+	other.mu.RLock()
+	words := append([]uint64(nil), other.words...)
+	m, k := other.m, other.k
+	other.mu.RUnlock()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.m != m || b.k != k {
+		return ErrIncompatible
+	}
+	for i, w := range words {
+		b.words[i] |= w
+	}
+	return nil
+}
+
+// Clear removes all items from the filter.
+func (b *BloomFilter[T]) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	clear(b.words)
+}
+
+// EstimatedFillRatio returns the fraction of bits that are set.
+func (b *BloomFilter[T]) EstimatedFillRatio() float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var set int
+	for _, w := range b.words {
+		set += bits.OnesCount64(w)
+	}
+	return float64(set) / float64(b.m)
+}
+
+// MarshalBinary encodes the filter as its parameters followed by its bit array.
+func (b *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	data := make([]byte, 0, 12+8*len(b.words))
+	data = binary.LittleEndian.AppendUint64(data, b.m)
+	data = binary.LittleEndian.AppendUint32(data, b.k)
+	for _, w := range b.words {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the filter with one decoded from data.
+func (b *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 12 {
+		return ErrInvalidData
+	}
+	m := binary.LittleEndian.Uint64(data)
+	k := binary.LittleEndian.Uint32(data[8:])
+	data = data[12:]
+	if k == 0 || k > bloomMaxHashes || len(data)%8 != 0 {
+		return ErrInvalidData
+	}
+	// Compare in bits to avoid overflowing (m+63)/64 for m near the top of uint64.
+	if bitLen := uint64(len(data)) * 8; m == 0 || m > bitLen || m <= bitLen-64 {
+		return ErrInvalidData
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.words, b.m, b.k = words, m, k
+	return nil
+}
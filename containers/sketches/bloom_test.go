@@ -0,0 +1,92 @@
+package sketches
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestBloomFilter(t *testing.T) {
+	const n = 1000
+	b := NewBloom[string](n, 0.01)
+	for i := 0; i < n; i++ {
+		b.Add(strconv.Itoa(i))
+	}
+	for i := 0; i < n; i++ {
+		if !b.MayContain(strconv.Itoa(i)) {
+			t.Fatalf("false negative for %d", i)
+		}
+	}
+	var fp int
+	for i := n; i < 2*n; i++ {
+		if b.MayContain(strconv.Itoa(i)) {
+			fp++
+		}
+	}
+	if rate := float64(fp) / n; rate > 0.03 {
+		t.Fatalf("false-positive rate too high: %.3f", rate)
+	}
+}
+
+func TestBloomFilterMergeAndBinary(t *testing.T) {
+	a := NewBloom[int](100, 0.01)
+	b := NewBloom[int](100, 0.01)
+	a.Add(1)
+	b.Add(2)
+	if err := a.Merge(b); err != nil {
+		t.Fatal(err)
+	}
+	if !a.MayContain(1) || !a.MayContain(2) {
+		t.Fatalf("expected merged filter to contain both items")
+	}
+	if err := a.Merge(NewBloom[int](10000, 0.01)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("expected ErrIncompatible, got %v", err)
+	}
+
+	data, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c BloomFilter[int]
+	if err := c.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !c.MayContain(1) || !c.MayContain(2) {
+		t.Fatalf("expected decoded filter to contain both items")
+	}
+	if err := c.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, ErrInvalidData) {
+		t.Fatalf("expected ErrInvalidData, got %v", err)
+	}
+}
+
+func TestBloomFilterUnmarshalMalformed(t *testing.T) {
+	header := func(m uint64, k uint32, words int) []byte {
+		data := binary.LittleEndian.AppendUint64(nil, m)
+		data = binary.LittleEndian.AppendUint32(data, k)
+		return append(data, make([]byte, 8*words)...)
+	}
+	for name, data := range map[string][]byte{
+		"short":         {1, 2, 3},
+		"overflowing m": header(^uint64(0), 3, 0),
+		"no words":      header(64, 3, 0),
+		"too few words": header(129, 3, 2),
+		"extra words":   header(64, 3, 2),
+		"zero k":        header(64, 0, 1),
+		"huge k":        header(64, ^uint32(0), 1),
+		"partial word":  append(header(64, 3, 1), 0),
+	} {
+		var b BloomFilter[int]
+		if err := b.UnmarshalBinary(data); !errors.Is(err, ErrInvalidData) {
+			t.Fatalf("%s: expected ErrInvalidData, got %v", name, err)
+		}
+	}
+	var b BloomFilter[int]
+	if err := b.UnmarshalBinary(header(65, 3, 2)); err != nil {
+		t.Fatal(err)
+	}
+	b.Add(1)
+	if !b.MayContain(1) {
+		t.Fatal("expected decoded filter to be usable")
+	}
+}
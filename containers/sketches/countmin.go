@@ -0,0 +1,96 @@
+package sketches
+
+import (
+	"math"
+	"sync"
+)
+
+// CountMinSketch is a thread-safe frequency estimator for high-volume streams.
+// Estimate never under-counts, and over-counts by at most epsilon times the
+// total count with probability 1-delta.
+type CountMinSketch[T any] struct {
+	mu     sync.RWMutex
+	width  uint64
+	depth  int
+	counts []uint64
+	total  uint64
+}
+
+// NewCountMinSketch creates a CountMinSketch with error bound epsilon and
+// failure probability delta. Values outside (0, 1) default to 0.001 and 0.01.
+func NewCountMinSketch[T any](epsilon, delta float64) *CountMinSketch[T] {
+	if epsilon <= 0 || epsilon >= 1 {
+		epsilon = 0.001
+	}
+	if delta <= 0 || delta >= 1 {
+		delta = 0.01
+	}
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+	return &CountMinSketch[T]{width: width, depth: depth, counts: make([]uint64, width*uint64(depth))}
+}
+
+// cells calls f with the index of item's counter in every row.
+func (s *CountMinSketch[T]) cells(item T, f func(i uint64)) {
+	h1 := hash64(item)
+	h2 := mix64(h1) | 1
+	for row := 0; row < s.depth; row++ {
+		f(uint64(row)*s.width + (h1+uint64(row)*h2)%s.width)
+	}
+}
+
+// Add records count occurrences of item.
+func (s *CountMinSketch[T]) Add(item T, count uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cells(item, func(i uint64) {
+		s.counts[i] += count
+	})
+	s.total += count
+}
+
+// Estimate returns the estimated number of occurrences of item.
+func (s *CountMinSketch[T]) Estimate(item T) uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	estimate := uint64(math.MaxUint64)
+	s.cells(item, func(i uint64) {
+		estimate = min(estimate, s.counts[i])
+	})
+	return estimate
+}
+
+// Total returns the sum of all counts added.
+func (s *CountMinSketch[T]) Total() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.total
+}
+
+// Merge adds the counts of other to s. Both sketches must have been created with
+// the same parameters, otherwise ErrIncompatible is returned.
+func (s *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	other.mu.RLock()
+	counts := append([]uint64(nil), other.counts...)
+	width, total := other.width, other.total
+	other.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.width != width || len(s.counts) != len(counts) {
+		return ErrIncompatible
+	}
+	for i, c := range counts {
+		s.counts[i] += c
+	}
+	s.total += total
+	return nil
+}
+
+// Clear resets all counts.
+func (s *CountMinSketch[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.counts)
+	s.total = 0
+}
@@ -0,0 +1,46 @@
+package sketches
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCountMinSketch(t *testing.T) {
+	s := NewCountMinSketch[string](0.001, 0.01)
+	s.Add("hot", 1000)
+	for i := 0; i < 500; i++ {
+		s.Add(string(rune('a'+i%26))+"cold", 1)
+	}
+	if got := s.Estimate("hot"); got < 1000 || got > 1000+uint64(0.001*float64(s.Total()))+1 {
+		t.Fatalf("estimate for hot out of bounds: %d", got)
+	}
+	if got := s.Estimate("missing"); got > 2 {
+		t.Fatalf("estimate for missing too high: %d", got)
+	}
+
+	other := NewCountMinSketch[string](0.001, 0.01)
+	other.Add("hot", 10)
+	if err := s.Merge(other); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Estimate("hot"); got < 1010 {
+		t.Fatalf("expected merged estimate of at least 1010, got %d", got)
+	}
+	if s.Total() != 1510 {
+		t.Fatalf("expected total 1510, got %d", s.Total())
+	}
+	if err := s.Merge(NewCountMinSketch[string](0.1, 0.01)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("expected ErrIncompatible, got %v", err)
+	}
+}
+
+func TestCountMinSketchSelfMerge(t *testing.T) {
+	s := NewCountMinSketch[string](0.001, 0.01)
+	s.Add("a", 3)
+	if err := s.Merge(s); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Estimate("a"); got != 6 || s.Total() != 6 {
+		t.Fatalf("expected self-merge to double the counts, got %d (total %d)", got, s.Total())
+	}
+}
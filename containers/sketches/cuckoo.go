@@ -0,0 +1,159 @@
+package sketches
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"sync"
+)
+
+const (
+	cuckooBucketSize = 4
+	cuckooMaxKicks   = 500
+)
+
+type cuckooBucket [cuckooBucketSize]uint16
+
+func (b *cuckooBucket) insert(fp uint16) bool {
+	for i, f := range b {
+		if f == 0 {
+			b[i] = fp
+			return true
+		}
+	}
+	return false
+}
+
+func (b *cuckooBucket) delete(fp uint16) bool {
+	for i, f := range b {
+		if f == fp {
+			b[i] = 0
+			return true
+		}
+	}
+	return false
+}
+
+func (b *cuckooBucket) contains(fp uint16) bool {
+	for _, f := range b {
+		if f == fp {
+			return true
+		}
+	}
+	return false
+}
+
+// CuckooFilter is a thread-safe probabilistic set that, unlike BloomFilter,
+// supports deleting items. It stores 16-bit fingerprints in buckets of four,
+// giving a false-positive rate of roughly 0.01%.
+//
+// Only delete items that were added: deleting an absent item may remove the
+// fingerprint of a colliding one and cause a false negative.
+type CuckooFilter[T any] struct {
+	mu      sync.RWMutex
+	buckets []cuckooBucket
+	mask    uint64
+	count   int
+}
+
+// NewCuckoo creates a CuckooFilter with room for about capacity items.
+// capacity less than 1 is treated as 1.
+func NewCuckoo[T any](capacity int) *CuckooFilter[T] {
+	n := uint64(max(capacity, 1)+cuckooBucketSize-1) / cuckooBucketSize
+	// The alternate bucket is found by xor, so the bucket count must be a power of two.
+	n = 1 << bits.Len64(n-1)
+	return &CuckooFilter[T]{buckets: make([]cuckooBucket, n), mask: n - 1}
+}
+
+// indexes returns the fingerprint of item and its primary bucket.
+func (c *CuckooFilter[T]) indexes(item T) (uint16, uint64) {
+	h := hash64(item)
+	fp := uint16(h >> 48)
+	if fp == 0 {
+		fp = 1
+	}
+	return fp, h & c.mask
+}
+
+func (c *CuckooFilter[T]) altIndex(i uint64, fp uint16) uint64 {
+	return (i ^ mix64(uint64(fp))) & c.mask
+}
+
+// Add adds item to the filter. It returns false if the filter is too full to
+// place the item, in which case the filter is left unchanged.
+func (c *CuckooFilter[T]) Add(item T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fp, i1 := c.indexes(item)
+	i2 := c.altIndex(i1, fp)
+	if c.buckets[i1].insert(fp) || c.buckets[i2].insert(fp) {
+		c.count++
+		return true
+	}
+
+	// Relocate existing fingerprints, remembering the swaps so they can be undone.
+	type kick struct {
+		bucket uint64
+		slot   int
+	}
+	kicks := make([]kick, 0, cuckooMaxKicks)
+	i := i1
+	if rand.IntN(2) == 1 {
+		i = i2
+	}
+	for range cuckooMaxKicks {
+		slot := rand.IntN(cuckooBucketSize)
+		fp, c.buckets[i][slot] = c.buckets[i][slot], fp
+		kicks = append(kicks, kick{i, slot})
+		i = c.altIndex(i, fp)
+		if c.buckets[i].insert(fp) {
+			c.count++
+			return true
+		}
+	}
+	for j := len(kicks) - 1; j >= 0; j-- {
+		k := kicks[j]
+		fp, c.buckets[k.bucket][k.slot] = c.buckets[k.bucket][k.slot], fp
+	}
+	return false
+}
+
+// MayContain reports whether item may have been added.
+// A false result means item is definitely not in the filter.
+func (c *CuckooFilter[T]) MayContain(item T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	fp, i1 := c.indexes(item)
+	return c.buckets[i1].contains(fp) || c.buckets[c.altIndex(i1, fp)].contains(fp)
+}
+
+// Delete removes one occurrence of item and reports whether it was found.
+func (c *CuckooFilter[T]) Delete(item T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fp, i1 := c.indexes(item)
+	if c.buckets[i1].delete(fp) || c.buckets[c.altIndex(i1, fp)].delete(fp) {
+		c.count--
+		return true
+	}
+	return false
+}
+
+// Len returns the number of items in the filter.
+func (c *CuckooFilter[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.count
+}
+
+// Cap returns the number of fingerprint slots in the filter.
+func (c *CuckooFilter[T]) Cap() int {
+	return len(c.buckets) * cuckooBucketSize
+}
+
+// Clear removes all items from the filter.
+func (c *CuckooFilter[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.buckets)
+	c.count = 0
+}
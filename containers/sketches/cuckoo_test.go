@@ -0,0 +1,56 @@
+package sketches
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCuckooFilter(t *testing.T) {
+	const n = 1000
+	c := NewCuckoo[string](2 * n)
+	for i := 0; i < n; i++ {
+		if !c.Add(strconv.Itoa(i)) {
+			t.Fatalf("failed to add %d", i)
+		}
+	}
+	for i := 0; i < n; i++ {
+		if !c.MayContain(strconv.Itoa(i)) {
+			t.Fatalf("false negative for %d", i)
+		}
+	}
+	for i := 0; i < n; i += 2 {
+		if !c.Delete(strconv.Itoa(i)) {
+			t.Fatalf("failed to delete %d", i)
+		}
+	}
+	if c.Len() != n/2 {
+		t.Fatalf("expected %d items, got %d", n/2, c.Len())
+	}
+	for i := 1; i < n; i += 2 {
+		if !c.MayContain(strconv.Itoa(i)) {
+			t.Fatalf("false negative for %d after deletes", i)
+		}
+	}
+	var fp int
+	for i := 0; i < n; i += 2 {
+		if c.MayContain(strconv.Itoa(i)) {
+			fp++
+		}
+	}
+	if fp > 5 {
+		t.Fatalf("too many deleted items still reported: %d", fp)
+	}
+}
+
+func TestCuckooFilterFull(t *testing.T) {
+	c := NewCuckoo[int](8)
+	added := 0
+	for i := 0; i < 100; i++ {
+		if c.Add(i) {
+			added++
+		}
+	}
+	if added > c.Cap() || c.Len() != added {
+		t.Fatalf("added %d items into %d slots, Len=%d", added, c.Cap(), c.Len())
+	}
+}
@@ -0,0 +1,64 @@
+package sketches
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// hash64 returns a 64-bit FNV-1a hash of v. Unlike hash/maphash it is stable across
+// processes, so serialized sketches from different nodes can be merged.
+func hash64[T any](v T) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	switch v := any(v).(type) {
+	case string:
+		h.Write([]byte(v))
+	case []byte:
+		h.Write(v)
+	case int:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(v)))
+	case int8:
+		h.Write([]byte{byte(v)})
+	case int16:
+		h.Write(binary.LittleEndian.AppendUint16(buf[:0], uint16(v)))
+	case int32:
+		h.Write(binary.LittleEndian.AppendUint32(buf[:0], uint32(v)))
+	case int64:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(v)))
+	case uint:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(v)))
+	case uint8:
+		h.Write([]byte{v})
+	case uint16:
+		h.Write(binary.LittleEndian.AppendUint16(buf[:0], v))
+	case uint32:
+		h.Write(binary.LittleEndian.AppendUint32(buf[:0], v))
+	case uint64:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], v))
+	case float32:
+		h.Write(binary.LittleEndian.AppendUint32(buf[:0], math.Float32bits(v)))
+	case float64:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], math.Float64bits(v)))
+	case bool:
+		if v {
+			h.Write([]byte{1})
+		} else {
+			h.Write([]byte{0})
+		}
+	default:
+		fmt.Fprintf(h, "%#v", v)
+	}
+	return h.Sum64()
+}
+
+// mix64 is the splitmix64 finalizer, used to derive independent hashes from one.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,136 @@
+package sketches
+
+import (
+	"math"
+	"math/bits"
+	"sync"
+)
+
+const (
+	// MinPrecision and MaxPrecision bound the HyperLogLog precision.
+	MinPrecision = 4
+	MaxPrecision = 18
+)
+
+// HyperLogLog is a thread-safe cardinality estimator. With precision p it uses
+// 2^p one-byte registers and has a standard error of about 1.04/sqrt(2^p).
+type HyperLogLog[T any] struct {
+	mu        sync.RWMutex
+	precision uint8
+	registers []uint8
+}
+
+// NewHyperLogLog creates a HyperLogLog with the given precision, which is clamped
+// to [MinPrecision, MaxPrecision]. A precision of 14 gives about 0.8% error in 16KiB.
+func NewHyperLogLog[T any](precision uint8) *HyperLogLog[T] {
+	precision = min(max(precision, MinPrecision), MaxPrecision)
+	return &HyperLogLog[T]{precision: precision, registers: make([]uint8, 1<<precision)}
+}
+
+// Add adds item to the estimator.
+func (h *HyperLogLog[T]) Add(item T) {
+	// FNV's high bits are poorly mixed, and they select the register.
+	x := mix64(hash64(item))
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// The precision is read under the lock since UnmarshalBinary may change it.
+	i := x >> (64 - h.precision)
+	rank := uint8(bits.LeadingZeros64(x<<h.precision|1<<(h.precision-1))) + 1
+	h.registers[i] = max(h.registers[i], rank)
+}
+
+// Count returns the estimated number of distinct items added.
+func (h *HyperLogLog[T]) Count() uint64 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	m := float64(len(h.registers))
+	var (
+		sum   float64
+		zeros int
+	)
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := hllAlpha(m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Use linear counting for small cardinalities.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+func hllAlpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/m)
+}
+
+// Merge folds other into h, so h estimates the union of both. Both estimators
+// must have the same precision, otherwise ErrIncompatible is returned.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h == other {
+		return nil
+	}
+	other.mu.RLock()
+	registers := append([]uint8(nil), other.registers...)
+	other.mu.RUnlock()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(registers) != len(h.registers) {
+		return ErrIncompatible
+	}
+	for i, r := range registers {
+		h.registers[i] = max(h.registers[i], r)
+	}
+	return nil
+}
+
+// Precision returns the precision of the estimator.
+func (h *HyperLogLog[T]) Precision() uint8 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.precision
+}
+
+// Clear resets the estimator.
+func (h *HyperLogLog[T]) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	clear(h.registers)
+}
+
+// MarshalBinary encodes the precision followed by the registers, so the state can
+// be shipped to and merged on another node.
+func (h *HyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	data := make([]byte, 0, 1+len(h.registers))
+	data = append(data, h.precision)
+	return append(data, h.registers...), nil
+}
+
+// UnmarshalBinary replaces the estimator with one decoded from data.
+func (h *HyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidData
+	}
+	precision := data[0]
+	if precision < MinPrecision || precision > MaxPrecision || len(data)-1 != 1<<precision {
+		return ErrInvalidData
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.precision = precision
+	h.registers = append([]uint8(nil), data[1:]...)
+	return nil
+}
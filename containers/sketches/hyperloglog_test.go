@@ -0,0 +1,75 @@
+package sketches
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	a := NewHyperLogLog[int](14)
+	b := NewHyperLogLog[int](14)
+	for i := 0; i < 50000; i++ {
+		a.Add(i)
+		a.Add(i)
+		b.Add(i + 25000)
+	}
+	assertNear(t, a.Count(), 50000, 0.03)
+	if err := a.Merge(b); err != nil {
+		t.Fatal(err)
+	}
+	assertNear(t, a.Count(), 75000, 0.03)
+
+	small := NewHyperLogLog[string](10)
+	for _, s := range []string{"a", "b", "c", "a"} {
+		small.Add(s)
+	}
+	if got := small.Count(); got != 3 {
+		t.Fatalf("expected 3 distinct items, got %d", got)
+	}
+
+	data, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c HyperLogLog[int]
+	if err := c.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if c.Count() != a.Count() {
+		t.Fatalf("decoded estimate %d differs from %d", c.Count(), a.Count())
+	}
+	if err := a.Merge(NewHyperLogLog[int](10)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("expected ErrIncompatible, got %v", err)
+	}
+}
+
+func assertNear(t *testing.T, got uint64, want float64, tolerance float64) {
+	t.Helper()
+	if math.Abs(float64(got)-want)/want > tolerance {
+		t.Fatalf("estimate %d is not within %.0f%% of %.0f", got, tolerance*100, want)
+	}
+}
+
+func TestHyperLogLogUnmarshalWhileAdding(t *testing.T) {
+	small, err := NewHyperLogLog[int](MinPrecision).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHyperLogLog[int](MaxPrecision)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range 1000 {
+			h.Add(i)
+			_ = h.Precision()
+		}
+	}()
+	if err := h.UnmarshalBinary(small); err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	if h.Precision() != MinPrecision {
+		t.Fatalf("expected precision %d, got %d", MinPrecision, h.Precision())
+	}
+}
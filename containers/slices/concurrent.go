@@ -0,0 +1,106 @@
+package slices
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"sync"
+)
+
+type concurrentShard[T any] struct {
+	mu   sync.Mutex
+	data []T
+	// Keep neighbouring shards on separate cache lines.
+	_ [32]byte
+}
+
+// ConcurrentSlice is an append-optimized generic list for write-heavy workloads
+// such as telemetry collection. Appends are spread across shards with their own
+// locks, so concurrent writers rarely contend; reads merge the shards. Items
+// appended by one goroutine keep their relative order, but the order across
+// goroutines is not preserved.
+type ConcurrentSlice[T any] struct {
+	shards []concurrentShard[T]
+}
+
+// NewConcurrent creates an empty ConcurrentSlice with the given number of shards.
+// A shards value less than 1 uses GOMAXPROCS shards.
+func NewConcurrent[T any](shards int) *ConcurrentSlice[T] {
+	if shards < 1 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+	return &ConcurrentSlice[T]{shards: make([]concurrentShard[T], shards)}
+}
+
+// Append adds items to a randomly chosen shard.
+func (l *ConcurrentSlice[T]) Append(items ...T) {
+	s := &l.shards[rand.N(len(l.shards))]
+	s.mu.Lock()
+	s.data = append(s.data, items...)
+	s.mu.Unlock()
+}
+
+// Len returns the number of items.
+func (l *ConcurrentSlice[T]) Len() int {
+	n := 0
+	for i := range l.shards {
+		s := &l.shards[i]
+		s.mu.Lock()
+		n += len(s.data)
+		s.mu.Unlock()
+	}
+	return n
+}
+
+// ToSlice returns a copy of the items, shard by shard.
+func (l *ConcurrentSlice[T]) ToSlice() []T {
+	var out []T
+	for i := range l.shards {
+		s := &l.shards[i]
+		s.mu.Lock()
+		out = append(out, s.data...)
+		s.mu.Unlock()
+	}
+	return out
+}
+
+// Drain removes and returns all items, shard by shard. Items appended while
+// Drain runs are either returned or kept for the next call, never lost.
+func (l *ConcurrentSlice[T]) Drain() []T {
+	var out []T
+	for i := range l.shards {
+		s := &l.shards[i]
+		s.mu.Lock()
+		data := s.data
+		s.data = nil
+		s.mu.Unlock()
+		out = append(out, data...)
+	}
+	return out
+}
+
+// Range calls f for every item, shard by shard, on a snapshot of each shard.
+// If f returns false, iteration stops.
+func (l *ConcurrentSlice[T]) Range(f func(item T) bool) {
+	for i := range l.shards {
+		s := &l.shards[i]
+		s.mu.Lock()
+		// Appends never modify existing elements, so the snapshot can be read unlocked.
+		data := s.data[:len(s.data):len(s.data)]
+		s.mu.Unlock()
+		for _, item := range data {
+			if !f(item) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all items.
+func (l *ConcurrentSlice[T]) Clear() {
+	for i := range l.shards {
+		s := &l.shards[i]
+		s.mu.Lock()
+		s.data = nil
+		s.mu.Unlock()
+	}
+}
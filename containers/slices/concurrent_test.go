@@ -0,0 +1,55 @@
+package slices
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSlice(t *testing.T) {
+	l := NewConcurrent[int](4)
+	const workers, items = 16, 500
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < items; i++ {
+				l.Append(w*items + i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if l.Len() != workers*items {
+		t.Fatalf("expected %d items, got %d", workers*items, l.Len())
+	}
+	got := l.Drain()
+	slices.Sort(got)
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("expected item %d, got %d", i, v)
+		}
+	}
+	if l.Len() != 0 {
+		t.Fatalf("expected Drain to empty the slice, got %d items", l.Len())
+	}
+}
+
+func BenchmarkConcurrentSliceAppend(b *testing.B) {
+	l := NewConcurrent[int](0)
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			l.Append(i)
+		}
+	})
+}
+
+func BenchmarkSliceAppend(b *testing.B) {
+	l := New[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			l.Push(i)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package slices
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// COWSlice is a copy-on-write generic slice-based list for read-dominated workloads.
+// Reads load an immutable snapshot atomically without locking;
+// writes are serialized, copy the snapshot and publish the modified copy.
+type COWSlice[T any] struct {
+	mu   sync.Mutex
+	data atomic.Pointer[[]T]
+}
+
+// NewCOW creates a new COWSlice with optional initial elements.
+func NewCOW[T any](items ...T) *COWSlice[T] {
+	l := &COWSlice[T]{}
+	d := slices.Clone(items)
+	l.data.Store(&d)
+	return l
+}
+
+// load returns the current immutable snapshot.
+func (l *COWSlice[T]) load() []T {
+	if p := l.data.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// update applies fn to a copy of the current snapshot and publishes the result.
+func (l *COWSlice[T]) update(fn func(data []T) []T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	d := fn(slices.Clone(l.load()))
+	l.data.Store(&d)
+}
+
+// Len returns the number of elements in the list.
+func (l *COWSlice[T]) Len() int {
+	return len(l.load())
+}
+
+// Get returns the item at index i.
+// It returns false if i is out of bounds.
+func (l *COWSlice[T]) Get(i int) (T, bool) {
+	data := l.load()
+	if i < 0 || i >= len(data) {
+		var zero T
+		return zero, false
+	}
+	return data[i], true
+}
+
+// Append adds items to the end of the list.
+func (l *COWSlice[T]) Append(items ...T) *COWSlice[T] {
+	if len(items) == 0 {
+		return l
+	}
+	l.update(func(data []T) []T { return append(data, items...) })
+	return l
+}
+
+// Set replaces the item at index i with value.
+// It returns false if i is out of bounds.
+func (l *COWSlice[T]) Set(i int, value T) bool {
+	ok := false
+	l.update(func(data []T) []T {
+		if i >= 0 && i < len(data) {
+			data[i] = value
+			ok = true
+		}
+		return data
+	})
+	return ok
+}
+
+// RemoveAt removes and returns the item at index i.
+// It returns false if i is out of bounds.
+func (l *COWSlice[T]) RemoveAt(i int) (T, bool) {
+	var (
+		v  T
+		ok bool
+	)
+	l.update(func(data []T) []T {
+		if i < 0 || i >= len(data) {
+			return data
+		}
+		v, ok = data[i], true
+		return slices.Delete(data, i, i+1)
+	})
+	return v, ok
+}
+
+// Clear removes all elements from the list.
+func (l *COWSlice[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var d []T
+	l.data.Store(&d)
+}
+
+// ToSlice returns a copy of the current snapshot.
+func (l *COWSlice[T]) ToSlice() []T {
+	return slices.Clone(l.load())
+}
+
+// Range iterates over the current snapshot of the list without copying it.
+// The callback receives the index and item. If it returns false, iteration stops.
+func (l *COWSlice[T]) Range(f func(index int, item T) bool) {
+	for i, v := range l.load() {
+		if !f(i, v) {
+			break
+		}
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (l *COWSlice[T]) MarshalJSON() ([]byte, error) {
+	data := l.load()
+	if data == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (l *COWSlice[T]) UnmarshalJSON(b []byte) error {
+	var data []T
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	l.mu.Lock()
+	l.data.Store(&data)
+	l.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,32 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCOWSliceSnapshotIsolation(t *testing.T) {
+	l := NewCOW(1, 2, 3)
+	before := l.ToSlice()
+
+	var seen []int
+	l.Range(func(i, item int) bool {
+		if i == 0 {
+			// Writes during Range publish a new snapshot and leave this one untouched.
+			l.Set(1, 20)
+			l.Append(4)
+			l.RemoveAt(2)
+		}
+		seen = append(seen, item)
+		return true
+	})
+	if !slices.Equal(seen, []int{1, 2, 3}) {
+		t.Fatalf("expected Range to see the snapshot taken before the writes, got %v", seen)
+	}
+	if !slices.Equal(before, []int{1, 2, 3}) {
+		t.Fatalf("expected an earlier copy to be unaffected, got %v", before)
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 20, 4}) {
+		t.Fatalf("expected the writes to be visible afterwards, got %v", got)
+	}
+}
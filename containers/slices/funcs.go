@@ -0,0 +1,79 @@
+package slices
+
+import (
+	"cmp"
+	"slices"
+)
+
+// IndexOf returns the index of the first occurrence of v in l, or -1 if not present.
+func IndexOf[T comparable](l *Slice[T], v T) int {
+	return l.IndexFunc(func(item T) bool { return item == v })
+}
+
+// Contains reports whether v is present in l.
+func Contains[T comparable](l *Slice[T], v T) bool {
+	return IndexOf(l, v) >= 0
+}
+
+// SortOrdered sorts l in place in ascending order.
+func SortOrdered[T cmp.Ordered](l *Slice[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	slices.Sort(l.data)
+}
+
+// Map returns a new list with fn applied to each item of a snapshot of l.
+func Map[T, U any](l *Slice[T], fn func(T) U) *Slice[U] {
+	items := l.ToSlice()
+	data := make([]U, len(items))
+	for i, v := range items {
+		data[i] = fn(v)
+	}
+	return &Slice[U]{data: data}
+}
+
+// Reduce folds a snapshot of l into a single value, starting from init.
+func Reduce[T, A any](l *Slice[T], init A, fn func(acc A, item T) A) A {
+	acc := init
+	for _, v := range l.ToSlice() {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// Unique removes duplicate items from l in place, keeping the first occurrence of each.
+func Unique[T comparable](l *Slice[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	seen := make(map[T]struct{}, len(l.data))
+	out := l.data[:0]
+	for _, v := range l.data {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	clear(l.data[len(out):])
+	l.data = out
+}
+
+// Concat returns a new list holding snapshots of lists, in order.
+func Concat[T any](lists ...*Slice[T]) *Slice[T] {
+	l := New[T]()
+	for _, other := range lists {
+		l.Extend(other)
+	}
+	return l
+}
+
+// Min returns the minimal item of l.
+// It returns false if l is empty.
+func Min[T cmp.Ordered](l *Slice[T]) (T, bool) {
+	return l.MinFunc(cmp.Less[T])
+}
+
+// Max returns the maximal item of l.
+// It returns false if l is empty.
+func Max[T cmp.Ordered](l *Slice[T]) (T, bool) {
+	return l.MaxFunc(cmp.Less[T])
+}
@@ -0,0 +1,31 @@
+package slices
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	got := Map(New(1, 2, 3), strconv.Itoa)
+	if !slices.Equal(got.ToSlice(), []string{"1", "2", "3"}) {
+		t.Fatalf("unexpected mapped items: %v", got.ToSlice())
+	}
+	if Map(New[int](), strconv.Itoa).Len() != 0 {
+		t.Fatal("expected mapping an empty list to give an empty list")
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := Reduce(New(1, 2, 3, 4), 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Fatalf("expected 10, got %d", sum)
+	}
+	joined := Reduce(New(1, 2, 3), "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if joined != "123" {
+		t.Fatalf("expected items to be folded in order, got %q", joined)
+	}
+	if got := Reduce(New[int](), 7, func(acc, v int) int { return acc + v }); got != 7 {
+		t.Fatalf("expected the initial value for an empty list, got %d", got)
+	}
+}
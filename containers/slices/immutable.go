@@ -0,0 +1,140 @@
+package slices
+
+import (
+	"encoding/json"
+	"iter"
+	"slices"
+)
+
+// ImmutableSlice is a generic slice-based list that is never modified after construction.
+// Methods that would mutate the list return a new list instead, so values can be
+// shared across goroutines and API boundaries without locking.
+// Sub-ranges share the underlying storage with the list they are taken from.
+// Every update copies the whole list and is O(n); for frequent updates of large
+// lists use PersistentVector, which shares structure between versions.
+type ImmutableSlice[T any] struct {
+	data []T
+}
+
+// NewImmutable creates a new ImmutableSlice holding a copy of items.
+func NewImmutable[T any](items ...T) ImmutableSlice[T] {
+	return ImmutableSlice[T]{data: slices.Clone(items)}
+}
+
+// Len returns the number of elements in the list.
+func (l ImmutableSlice[T]) Len() int {
+	return len(l.data)
+}
+
+// Get returns the item at index i.
+// It returns false if i is out of bounds.
+func (l ImmutableSlice[T]) Get(i int) (T, bool) {
+	if i < 0 || i >= len(l.data) {
+		var zero T
+		return zero, false
+	}
+	return l.data[i], true
+}
+
+// Append returns a new list with items added to the end.
+func (l ImmutableSlice[T]) Append(items ...T) ImmutableSlice[T] {
+	if len(items) == 0 {
+		return l
+	}
+	data := make([]T, 0, len(l.data)+len(items))
+	data = append(data, l.data...)
+	return ImmutableSlice[T]{data: append(data, items...)}
+}
+
+// Set returns a new list with the item at index i replaced by value.
+// It returns false if i is out of bounds.
+func (l ImmutableSlice[T]) Set(i int, value T) (ImmutableSlice[T], bool) {
+	if i < 0 || i >= len(l.data) {
+		return l, false
+	}
+	data := slices.Clone(l.data)
+	data[i] = value
+	return ImmutableSlice[T]{data: data}, true
+}
+
+// Insert returns a new list with items inserted at index i.
+// It returns false if i is out of bounds; i == Len() appends.
+func (l ImmutableSlice[T]) Insert(i int, items ...T) (ImmutableSlice[T], bool) {
+	if i < 0 || i > len(l.data) {
+		return l, false
+	}
+	data := make([]T, 0, len(l.data)+len(items))
+	data = append(data, l.data[:i]...)
+	data = append(data, items...)
+	return ImmutableSlice[T]{data: append(data, l.data[i:]...)}, true
+}
+
+// RemoveAt returns a new list without the item at index i.
+// It returns false if i is out of bounds.
+func (l ImmutableSlice[T]) RemoveAt(i int) (ImmutableSlice[T], bool) {
+	if i < 0 || i >= len(l.data) {
+		return l, false
+	}
+	data := make([]T, 0, len(l.data)-1)
+	data = append(data, l.data[:i]...)
+	return ImmutableSlice[T]{data: append(data, l.data[i+1:]...)}, true
+}
+
+// SubSlice returns the items from index from up to, but not including, to.
+// The result shares storage with the list. It returns false if the range is out of bounds.
+func (l ImmutableSlice[T]) SubSlice(from, to int) (ImmutableSlice[T], bool) {
+	if from < 0 || from > to || to > len(l.data) {
+		return ImmutableSlice[T]{}, false
+	}
+	// Cap the capacity so no later append can write into the shared storage.
+	return ImmutableSlice[T]{data: l.data[from:to:to]}, true
+}
+
+// ToSlice returns a copy of the items.
+func (l ImmutableSlice[T]) ToSlice() []T {
+	return slices.Clone(l.data)
+}
+
+// ToMutable returns a new Slice holding a copy of the items.
+func (l ImmutableSlice[T]) ToMutable() *Slice[T] {
+	return New(l.data...)
+}
+
+// Range iterates over the list.
+// The callback receives the index and item. If it returns false, iteration stops.
+func (l ImmutableSlice[T]) Range(f func(index int, item T) bool) {
+	for i, v := range l.data {
+		if !f(i, v) {
+			break
+		}
+	}
+}
+
+// All returns an iterator over index-item pairs of the list.
+func (l ImmutableSlice[T]) All() iter.Seq2[int, T] {
+	return slices.All(l.data)
+}
+
+// Values returns an iterator over items of the list.
+func (l ImmutableSlice[T]) Values() iter.Seq[T] {
+	return slices.Values(l.data)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (l ImmutableSlice[T]) MarshalJSON() ([]byte, error) {
+	if l.data == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(l.data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It is intended for decoding only; the list must not be shared while it is being decoded.
+func (l *ImmutableSlice[T]) UnmarshalJSON(b []byte) error {
+	var data []T
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	l.data = data
+	return nil
+}
@@ -0,0 +1,37 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestImmutableSliceUpdatesLeaveReceiverUnchanged(t *testing.T) {
+	l := NewImmutable(1, 2, 3)
+	appended := l.Append(4)
+	set, _ := l.Set(0, 10)
+	inserted, _ := l.Insert(1, 5, 6)
+	removed, _ := l.RemoveAt(2)
+
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("expected the receiver to be unchanged, got %v", got)
+	}
+	for _, tc := range []struct {
+		got  ImmutableSlice[int]
+		want []int
+	}{
+		{appended, []int{1, 2, 3, 4}},
+		{set, []int{10, 2, 3}},
+		{inserted, []int{1, 5, 6, 2, 3}},
+		{removed, []int{1, 2}},
+	} {
+		if got := tc.got.ToSlice(); !slices.Equal(got, tc.want) {
+			t.Fatalf("expected %v, got %v", tc.want, got)
+		}
+	}
+
+	sub, _ := l.SubSlice(0, 2)
+	sub = sub.Append(99)
+	if v, _ := l.Get(2); v != 3 {
+		t.Fatalf("expected appending to a sub-slice not to overwrite the parent, got %d", v)
+	}
+}
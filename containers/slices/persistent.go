@@ -0,0 +1,232 @@
+package slices
+
+import "slices"
+
+const (
+	pvBits  = 5
+	pvWidth = 1 << pvBits
+	pvMask  = pvWidth - 1
+)
+
+// pvNode is a trie node: internal nodes hold children, leaves hold values.
+// Nodes are never modified once they are reachable from a PersistentVector.
+type pvNode[T any] struct {
+	children []*pvNode[T]
+	values   []T
+}
+
+// PersistentVector is an immutable generic list with structural sharing. Append,
+// Set and Pop return new versions that share all but O(log32 n) nodes with the
+// original, so keeping many historical versions of a large list is cheap.
+// Like ImmutableSlice it is a value type that is safe to share between goroutines.
+type PersistentVector[T any] struct {
+	length int
+	shift  uint
+	root   *pvNode[T]
+	// tail holds the last partial leaf outside the trie, making Append amortized O(1).
+	tail []T
+}
+
+// NewPersistent creates a PersistentVector holding items.
+func NewPersistent[T any](items ...T) PersistentVector[T] {
+	return PersistentVector[T]{}.Append(items...)
+}
+
+// Len returns the number of elements in the vector.
+func (v PersistentVector[T]) Len() int {
+	return v.length
+}
+
+func (v PersistentVector[T]) tailOffset() int {
+	if v.length < pvWidth {
+		return 0
+	}
+	return (v.length - 1) >> pvBits << pvBits
+}
+
+// leaf returns the values of the leaf holding index i, which must be in range.
+func (v PersistentVector[T]) leaf(i int) []T {
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+	n := v.root
+	for level := v.shift; level > 0; level -= pvBits {
+		n = n.children[(i>>level)&pvMask]
+	}
+	return n.values
+}
+
+// Get returns the item at index i.
+// It returns false if i is out of bounds.
+func (v PersistentVector[T]) Get(i int) (T, bool) {
+	if i < 0 || i >= v.length {
+		var zero T
+		return zero, false
+	}
+	return v.leaf(i)[i&pvMask], true
+}
+
+// Append returns a new vector with items added to the end.
+func (v PersistentVector[T]) Append(items ...T) PersistentVector[T] {
+	for _, item := range items {
+		v = v.push(item)
+	}
+	return v
+}
+
+func (v PersistentVector[T]) push(item T) PersistentVector[T] {
+	if v.root == nil {
+		v.root, v.shift = &pvNode[T]{}, pvBits
+	}
+	if v.length-v.tailOffset() < pvWidth {
+		// Copy the tail, since older versions may share its backing array.
+		tail := make([]T, len(v.tail)+1, pvWidth)
+		copy(tail, v.tail)
+		tail[len(v.tail)] = item
+		v.tail = tail
+		v.length++
+		return v
+	}
+	leaf := &pvNode[T]{values: v.tail}
+	if v.length>>pvBits > 1<<v.shift {
+		// The trie is full: grow a new root.
+		v.root = &pvNode[T]{children: []*pvNode[T]{v.root, newPVPath(v.shift, leaf)}}
+		v.shift += pvBits
+	} else {
+		v.root = v.pushLeaf(v.shift, v.root, leaf)
+	}
+	v.tail = append(make([]T, 0, pvWidth), item)
+	v.length++
+	return v
+}
+
+func newPVPath[T any](level uint, n *pvNode[T]) *pvNode[T] {
+	if level == 0 {
+		return n
+	}
+	return &pvNode[T]{children: []*pvNode[T]{newPVPath(level-pvBits, n)}}
+}
+
+// pushLeaf returns a copy of parent with leaf added as the next leaf below it.
+func (v PersistentVector[T]) pushLeaf(level uint, parent, leaf *pvNode[T]) *pvNode[T] {
+	i := ((v.length - 1) >> level) & pvMask
+	n := &pvNode[T]{children: slices.Clone(parent.children)}
+	var child *pvNode[T]
+	switch {
+	case level == pvBits:
+		child = leaf
+	case i < len(parent.children):
+		child = v.pushLeaf(level-pvBits, parent.children[i], leaf)
+	default:
+		child = newPVPath(level-pvBits, leaf)
+	}
+	if i < len(n.children) {
+		n.children[i] = child
+	} else {
+		n.children = append(n.children, child)
+	}
+	return n
+}
+
+// Set returns a new vector with the item at index i replaced by value.
+// It returns false if i is out of bounds.
+func (v PersistentVector[T]) Set(i int, value T) (PersistentVector[T], bool) {
+	if i < 0 || i >= v.length {
+		return v, false
+	}
+	if i >= v.tailOffset() {
+		tail := slices.Clone(v.tail)
+		tail[i&pvMask] = value
+		v.tail = tail
+		return v, true
+	}
+	v.root = setPV(v.shift, v.root, i, value)
+	return v, true
+}
+
+func setPV[T any](level uint, n *pvNode[T], i int, value T) *pvNode[T] {
+	if level == 0 {
+		values := slices.Clone(n.values)
+		values[i&pvMask] = value
+		return &pvNode[T]{values: values}
+	}
+	children := slices.Clone(n.children)
+	j := (i >> level) & pvMask
+	children[j] = setPV(level-pvBits, children[j], i, value)
+	return &pvNode[T]{children: children}
+}
+
+// Pop returns a new vector without its last item, and that item.
+// It returns false if the vector is empty.
+func (v PersistentVector[T]) Pop() (PersistentVector[T], T, bool) {
+	var zero T
+	switch {
+	case v.length == 0:
+		return v, zero, false
+	case v.length == 1:
+		return PersistentVector[T]{}, v.tail[0], true
+	}
+	last := v.tail[len(v.tail)-1]
+	if len(v.tail) > 1 {
+		v.tail = v.tail[: len(v.tail)-1 : len(v.tail)-1]
+		v.length--
+		return v, last, true
+	}
+	// The tail becomes empty: move the last leaf of the trie into it.
+	v.tail = v.leaf(v.length - 2)
+	root := v.popLeaf(v.shift, v.root)
+	if root == nil {
+		root = &pvNode[T]{}
+	}
+	if v.shift > pvBits && len(root.children) == 1 {
+		root = root.children[0]
+		v.shift -= pvBits
+	}
+	v.root = root
+	v.length--
+	return v, last, true
+}
+
+// popLeaf returns a copy of n without its last leaf, or nil if n becomes empty.
+func (v PersistentVector[T]) popLeaf(level uint, n *pvNode[T]) *pvNode[T] {
+	i := ((v.length - 2) >> level) & pvMask
+	if level > pvBits {
+		child := v.popLeaf(level-pvBits, n.children[i])
+		if child == nil && i == 0 {
+			return nil
+		}
+		children := slices.Clone(n.children[:i+1])
+		if child == nil {
+			children = children[:i]
+		} else {
+			children[i] = child
+		}
+		return &pvNode[T]{children: children}
+	}
+	if i == 0 {
+		return nil
+	}
+	return &pvNode[T]{children: slices.Clone(n.children[:i])}
+}
+
+// Range calls f for every index and item in order.
+// If f returns false, iteration stops.
+func (v PersistentVector[T]) Range(f func(i int, item T) bool) {
+	for base := 0; base < v.length; base += pvWidth {
+		for j, item := range v.leaf(base) {
+			if !f(base+j, item) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a copy of the items.
+func (v PersistentVector[T]) ToSlice() []T {
+	items := make([]T, 0, v.length)
+	v.Range(func(_ int, item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
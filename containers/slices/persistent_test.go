@@ -0,0 +1,67 @@
+package slices
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestPersistentVectorMatchesSlice(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	v := NewPersistent[int]()
+	var want []int
+	type version struct {
+		v    PersistentVector[int]
+		want []int
+	}
+	var history []version
+	for step := 0; step < 5000; step++ {
+		switch op := r.IntN(10); {
+		case op < 6:
+			v = v.Append(step)
+			want = append(want, step)
+		case op < 8 && len(want) > 0:
+			i := r.IntN(len(want))
+			v, _ = v.Set(i, -step)
+			want[i] = -step
+		case len(want) > 0:
+			var last int
+			v, last, _ = v.Pop()
+			if last != want[len(want)-1] {
+				t.Fatalf("step %d: expected to pop %d, got %d", step, want[len(want)-1], last)
+			}
+			want = want[:len(want)-1]
+		}
+		if step%250 == 0 {
+			history = append(history, version{v, slices.Clone(want)})
+		}
+	}
+	if got := v.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("vector diverged from reference slice")
+	}
+	for i, h := range history {
+		if got := h.v.ToSlice(); !slices.Equal(got, h.want) {
+			t.Fatalf("version %d was modified by later operations", i)
+		}
+	}
+}
+
+func TestPersistentVector(t *testing.T) {
+	v := NewPersistent(1, 2, 3)
+	w, ok := v.Set(1, 20)
+	if !ok {
+		t.Fatalf("expected Set in range to succeed")
+	}
+	if got, _ := v.Get(1); got != 2 {
+		t.Fatalf("expected original to be unchanged, got %d", got)
+	}
+	if got, _ := w.Get(1); got != 20 {
+		t.Fatalf("expected new version to hold 20, got %d", got)
+	}
+	if _, ok := v.Set(3, 0); ok {
+		t.Fatalf("expected Set out of range to fail")
+	}
+	if _, ok := v.Get(-1); ok {
+		t.Fatalf("expected Get out of range to fail")
+	}
+}
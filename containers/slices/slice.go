@@ -1,8 +1,17 @@
 package slices
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"iter"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
+
+	"github.com/go-kratos/kit/syncx"
 )
 
 // Slice is a thread-safe generic slice-based list.
@@ -10,6 +19,10 @@ import (
 type Slice[T any] struct {
 	mu   sync.RWMutex
 	data []T
+	// base is the backing array data was advanced within by PopFront, and head
+	// the number of freed slots in it before data, which PushFront can reuse.
+	base []T
+	head int
 }
 
 // New creates a new Slice with optional initial elements.
@@ -19,6 +32,20 @@ func New[T any](items ...T) *Slice[T] {
 	return &Slice[T]{data: d}
 }
 
+// NewWithCap creates a new empty Slice with capacity for n elements.
+func NewWithCap[T any](n int) *Slice[T] {
+	return &Slice[T]{data: make([]T, 0, max(n, 0))}
+}
+
+// Repeat creates a new Slice holding n copies of value.
+func Repeat[T any](value T, n int) *Slice[T] {
+	l := NewWithCap[T](n)
+	for range n {
+		l.data = append(l.data, value)
+	}
+	return l
+}
+
 // Len returns the number of elements in the list.
 func (l *Slice[T]) Len() int {
 	l.mu.RLock()
@@ -26,13 +53,47 @@ func (l *Slice[T]) Len() int {
 	return len(l.data)
 }
 
+// Cap returns the capacity of the underlying storage.
+func (l *Slice[T]) Cap() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return cap(l.data)
+}
+
+// Grow increases the capacity, if necessary, to guarantee space for another n elements.
+func (l *Slice[T]) Grow(n int) {
+	if n <= 0 {
+		return
+	}
+	l.mu.Lock()
+	l.data = slices.Grow(l.data, n)
+	l.headRoom()
+	l.mu.Unlock()
+}
+
 // Clear removes all elements from the list.
+// The capacity is retained; use Shrink to release it.
 func (l *Slice[T]) Clear() {
 	l.mu.Lock()
+	clear(l.data)
 	l.data = l.data[:0]
 	l.mu.Unlock()
 }
 
+// Shrink reallocates the underlying storage to fit the current length,
+// releasing memory retained after large removals.
+func (l *Slice[T]) Shrink() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if cap(l.data) == len(l.data) {
+		return
+	}
+	data := make([]T, len(l.data))
+	copy(data, l.data)
+	l.data = data
+	l.base, l.head = nil, 0
+}
+
 // Append adds items to the end of the list.
 func (l *Slice[T]) Append(items ...T) *Slice[T] {
 	if len(items) == 0 {
@@ -40,10 +101,122 @@ func (l *Slice[T]) Append(items ...T) *Slice[T] {
 	}
 	l.mu.Lock()
 	l.data = append(l.data, items...)
+	l.headRoom()
 	l.mu.Unlock()
 	return l
 }
 
+// Push adds items to the top (end) of the list, so it can be used as a stack.
+func (l *Slice[T]) Push(items ...T) *Slice[T] {
+	return l.Append(items...)
+}
+
+// Pop removes and returns the item at the top (end) of the list.
+// It returns false if the list is empty.
+func (l *Slice[T]) Pop() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var zero T
+	n := len(l.data)
+	if n == 0 {
+		return zero, false
+	}
+	v := l.data[n-1]
+	l.data[n-1] = zero
+	l.data = l.data[:n-1]
+	return v, true
+}
+
+// Peek returns the item at the top (end) of the list without removing it.
+// It returns false if the list is empty.
+func (l *Slice[T]) Peek() (T, bool) {
+	return l.Last()
+}
+
+// First returns the first item of the list.
+// It returns false if the list is empty.
+func (l *Slice[T]) First() (T, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if len(l.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return l.data[0], true
+}
+
+// Last returns the last item of the list.
+// It returns false if the list is empty.
+func (l *Slice[T]) Last() (T, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	n := len(l.data)
+	if n == 0 {
+		var zero T
+		return zero, false
+	}
+	return l.data[n-1], true
+}
+
+// headRoom returns the number of freed slots before data that PushFront can reuse.
+// It forgets them, releasing the old storage, once data has moved to another array.
+// The caller must hold the lock.
+func (l *Slice[T]) headRoom() int {
+	if l.head > 0 && cap(l.data) > 0 && cap(l.base)-l.head == cap(l.data) &&
+		&l.base[l.head:cap(l.base)][0] == &l.data[:cap(l.data)][0] {
+		return l.head
+	}
+	l.base, l.head = nil, 0
+	return 0
+}
+
+// PushFront adds items to the front of the list, preserving their order.
+// It reuses slots freed by PopFront when there are enough, which makes it
+// O(len(items)); otherwise it shifts every element and is O(n).
+func (l *Slice[T]) PushFront(items ...T) *Slice[T] {
+	if len(items) == 0 {
+		return l
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if k := len(items); l.headRoom() >= k {
+		l.head -= k
+		l.data = l.base[l.head : l.head+len(l.data)+k]
+		copy(l.data, items)
+		return l
+	}
+	l.data = slices.Insert(l.data, 0, items...)
+	l.base, l.head = nil, 0
+	return l
+}
+
+// PopFront removes and returns the item at the front of the list.
+// It returns false if the list is empty.
+// The head is advanced over the backing array instead of shifting the remaining
+// elements, so consuming the list as a FIFO is amortized O(1). The freed slots are
+// reused by PushFront, or released when Append has to reallocate.
+func (l *Slice[T]) PopFront() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var zero T
+	if len(l.data) == 0 {
+		return zero, false
+	}
+	if l.headRoom() == 0 {
+		l.base = l.data[:cap(l.data)]
+	}
+	v := l.data[0]
+	l.data[0] = zero
+	l.data = l.data[1:]
+	l.head++
+	if len(l.data) == 0 {
+		// Nothing is left, so the whole array can be reused from its start.
+		l.data = l.base[:0]
+		l.base, l.head = nil, 0
+	}
+	return v, true
+}
+
 // Get returns the item at index i.
 // It returns false if i is out of bounds.
 func (l *Slice[T]) Get(i int) (T, bool) {
@@ -68,6 +241,19 @@ func (l *Slice[T]) Set(i int, value T) bool {
 	return true
 }
 
+// Insert inserts items at index i, shifting later elements to make room.
+// It returns false if i is out of bounds; i == Len() appends.
+func (l *Slice[T]) Insert(i int, items ...T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if i < 0 || i > len(l.data) {
+		return false
+	}
+	l.data = slices.Insert(l.data, i, items...)
+	l.headRoom()
+	return true
+}
+
 // RemoveAt removes and returns the item at index i.
 // It returns false if i is out of bounds.
 func (l *Slice[T]) RemoveAt(i int) (T, bool) {
@@ -137,9 +323,13 @@ func (l *Slice[T]) Clone() *Slice[T] {
 }
 
 // MarshalJSON implements the json.Marshaler interface.
+// An empty list, including the zero value, is encoded as [] rather than null.
 func (l *Slice[T]) MarshalJSON() ([]byte, error) {
 	l.mu.RLock()
 	defer l.mu.RUnlock()
+	if l.data == nil {
+		return []byte("[]"), nil
+	}
 	return json.Marshal(l.data)
 }
 
@@ -151,6 +341,456 @@ func (l *Slice[T]) UnmarshalJSON(b []byte) error {
 	}
 	l.mu.Lock()
 	l.data = data
+	l.base, l.head = nil, 0
 	l.mu.Unlock()
 	return nil
 }
+
+// IndexFunc returns the index of the first item satisfying pred, or -1 if none do.
+func (l *Slice[T]) IndexFunc(pred func(T) bool) int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return slices.IndexFunc(l.data, pred)
+}
+
+// ContainsFunc reports whether at least one item satisfies pred.
+func (l *Slice[T]) ContainsFunc(pred func(T) bool) bool {
+	return l.IndexFunc(pred) >= 0
+}
+
+// RemoveFunc removes all items satisfying pred in a single pass
+// and returns the number of items removed.
+func (l *Slice[T]) RemoveFunc(pred func(T) bool) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := len(l.data)
+	l.data = slices.DeleteFunc(l.data, pred)
+	return n - len(l.data)
+}
+
+// Sort sorts the list in place using less.
+// The sort is not guaranteed to be stable.
+func (l *Slice[T]) Sort(less func(a, b T) bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sort.Slice(l.data, func(i, j int) bool { return less(l.data[i], l.data[j]) })
+}
+
+// SortStable sorts the list in place using less, keeping the original order of equal items.
+func (l *Slice[T]) SortStable(less func(a, b T) bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sort.SliceStable(l.data, func(i, j int) bool { return less(l.data[i], l.data[j]) })
+}
+
+// IsSorted reports whether the list is sorted according to less.
+func (l *Slice[T]) IsSorted(less func(a, b T) bool) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return sort.SliceIsSorted(l.data, func(i, j int) bool { return less(l.data[i], l.data[j]) })
+}
+
+// Reverse reverses the order of the items in place.
+func (l *Slice[T]) Reverse() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	slices.Reverse(l.data)
+}
+
+// Filter returns a new list containing the items satisfying pred.
+func (l *Slice[T]) Filter(pred func(T) bool) *Slice[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	data := make([]T, 0)
+	for _, v := range l.data {
+		if pred(v) {
+			data = append(data, v)
+		}
+	}
+	return &Slice[T]{data: data}
+}
+
+// Keep retains only the items satisfying pred, removing the rest in place.
+func (l *Slice[T]) Keep(pred func(T) bool) {
+	l.RemoveFunc(func(v T) bool { return !pred(v) })
+}
+
+// Shuffle randomly permutes the items in place.
+func (l *Slice[T]) Shuffle() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rand.Shuffle(len(l.data), func(i, j int) { l.data[i], l.data[j] = l.data[j], l.data[i] })
+}
+
+// ShuffleRand randomly permutes the items in place using r as the source of randomness.
+func (l *Slice[T]) ShuffleRand(r *rand.Rand) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	r.Shuffle(len(l.data), func(i, j int) { l.data[i], l.data[j] = l.data[j], l.data[i] })
+}
+
+// BinarySearchFunc searches for target in a list sorted according to cmp.
+// It returns the position where target is found, or where it would be inserted,
+// and whether it was found.
+func (l *Slice[T]) BinarySearchFunc(target T, cmp func(a, b T) int) (int, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return slices.BinarySearchFunc(l.data, target, cmp)
+}
+
+// SubSlice returns a new list holding a copy of the items from index from up to, but not including, to.
+// It returns false if the range is out of bounds.
+func (l *Slice[T]) SubSlice(from, to int) (*Slice[T], bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if from < 0 || from > to || to > len(l.data) {
+		return nil, false
+	}
+	return New(l.data[from:to]...), true
+}
+
+// Chunk splits a snapshot of the list into consecutive lists of at most size items.
+// It returns nil if size is not positive.
+func (l *Slice[T]) Chunk(size int) []*Slice[T] {
+	if size <= 0 {
+		return nil
+	}
+	items := l.ToSlice()
+	chunks := make([]*Slice[T], 0, (len(items)+size-1)/size)
+	for c := range slices.Chunk(items, size) {
+		chunks = append(chunks, &Slice[T]{data: c})
+	}
+	return chunks
+}
+
+// Dedupe removes duplicate items in place, keeping the first occurrence of each.
+// Items are compared with eq, which makes this O(n²); use Unique for comparable types.
+func (l *Slice[T]) Dedupe(eq func(a, b T) bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	out := l.data[:0]
+	for _, v := range l.data {
+		if !slices.ContainsFunc(out, func(seen T) bool { return eq(seen, v) }) {
+			out = append(out, v)
+		}
+	}
+	clear(l.data[len(out):])
+	l.data = out
+}
+
+// Extend appends a snapshot of other to the end of the list in one operation.
+func (l *Slice[T]) Extend(other *Slice[T]) *Slice[T] {
+	return l.Append(other.ToSlice()...)
+}
+
+// All returns an iterator over index-item pairs of a snapshot of the list.
+func (l *Slice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		l.Range(yield)
+	}
+}
+
+// Values returns an iterator over items of a snapshot of the list.
+func (l *Slice[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		l.Range(func(_ int, item T) bool { return yield(item) })
+	}
+}
+
+// Swap exchanges the items at indexes i and j.
+// It returns false if either index is out of bounds.
+func (l *Slice[T]) Swap(i, j int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if i < 0 || i >= len(l.data) || j < 0 || j >= len(l.data) {
+		return false
+	}
+	l.data[i], l.data[j] = l.data[j], l.data[i]
+	return true
+}
+
+// MinFunc returns the minimal item according to less.
+// If several items are minimal, the first one is returned.
+// It returns false if the list is empty.
+func (l *Slice[T]) MinFunc(less func(a, b T) bool) (T, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var zero T
+	if len(l.data) == 0 {
+		return zero, false
+	}
+	m := l.data[0]
+	for _, v := range l.data[1:] {
+		if less(v, m) {
+			m = v
+		}
+	}
+	return m, true
+}
+
+// MaxFunc returns the maximal item according to less.
+// If several items are maximal, the first one is returned.
+// It returns false if the list is empty.
+func (l *Slice[T]) MaxFunc(less func(a, b T) bool) (T, bool) {
+	return l.MinFunc(func(a, b T) bool { return less(b, a) })
+}
+
+// RangeParallel calls f for every item of a snapshot of the list using at most
+// workers goroutines, and returns once all calls have completed.
+// A workers value less than 1 is treated as 1.
+func (l *Slice[T]) RangeParallel(workers int, f func(index int, item T)) {
+	items := l.ToSlice()
+	weight := int64(max(workers, 1))
+	sem := syncx.NewSemaphore(weight)
+	for i, item := range items {
+		_ = sem.Acquire(context.Background(), 1)
+		go func() {
+			defer sem.Release(1)
+			f(i, item)
+		}()
+	}
+	// Acquiring the full weight waits for every call to release its share.
+	_ = sem.Acquire(context.Background(), weight)
+}
+
+// RemoveRange removes the items from index from up to, but not including, to.
+// It returns false if the range is out of bounds.
+func (l *Slice[T]) RemoveRange(from, to int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if from < 0 || from > to || to > len(l.data) {
+		return false
+	}
+	l.data = slices.Delete(l.data, from, to)
+	return true
+}
+
+// Fill overwrites every item in the list with value.
+func (l *Slice[T]) Fill(value T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range l.data {
+		l.data[i] = value
+	}
+}
+
+// Equal reports whether the list and other hold equal items in the same order,
+// comparing snapshots of both with eq.
+func (l *Slice[T]) Equal(other *Slice[T], eq func(a, b T) bool) bool {
+	items := other.ToSlice()
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return slices.EqualFunc(l.data, items, eq)
+}
+
+// Join concatenates the items of the list, formatted with format and separated by sep.
+// If format is nil, string items are joined as-is and others are formatted with fmt.Sprint.
+func (l *Slice[T]) Join(sep string, format func(T) string) string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if format == nil {
+		if ss, ok := any(l.data).([]string); ok {
+			return strings.Join(ss, sep)
+		}
+		format = func(v T) string { return fmt.Sprint(v) }
+	}
+	var b strings.Builder
+	for i, v := range l.data {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(format(v))
+	}
+	return b.String()
+}
+
+// Sample returns up to n distinct items chosen at random.
+func (l *Slice[T]) Sample(n int) []T {
+	return l.sample(n, rand.Intn)
+}
+
+// SampleRand returns up to n distinct items chosen at random using r as the source of randomness.
+func (l *Slice[T]) SampleRand(r *rand.Rand, n int) []T {
+	return l.sample(n, r.Intn)
+}
+
+// Pick returns a random item.
+// It returns false if the list is empty.
+func (l *Slice[T]) Pick() (T, bool) {
+	return l.pick(rand.Intn)
+}
+
+// PickRand returns a random item using r as the source of randomness.
+// It returns false if the list is empty.
+func (l *Slice[T]) PickRand(r *rand.Rand) (T, bool) {
+	return l.pick(r.Intn)
+}
+
+func (l *Slice[T]) sample(n int, intn func(int) int) []T {
+	l.mu.RLock()
+	cpy := make([]T, len(l.data))
+	copy(cpy, l.data)
+	l.mu.RUnlock()
+	n = min(max(n, 0), len(cpy))
+	// Partial Fisher-Yates: only the first n positions need to be settled.
+	for i := range n {
+		j := i + intn(len(cpy)-i)
+		cpy[i], cpy[j] = cpy[j], cpy[i]
+	}
+	return cpy[:n:n]
+}
+
+func (l *Slice[T]) pick(intn func(int) int) (T, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if len(l.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return l.data[intn(len(l.data))], true
+}
+
+// Any reports whether at least one item satisfies pred.
+func (l *Slice[T]) Any(pred func(T) bool) bool {
+	return l.ContainsFunc(pred)
+}
+
+// Every reports whether every item satisfies pred.
+// It returns true for an empty list.
+func (l *Slice[T]) Every(pred func(T) bool) bool {
+	return !l.ContainsFunc(func(v T) bool { return !pred(v) })
+}
+
+// Count returns the number of items satisfying pred.
+func (l *Slice[T]) Count(pred func(T) bool) int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	n := 0
+	for _, v := range l.data {
+		if pred(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Partition splits a snapshot of the list into the items satisfying pred and the rest.
+func (l *Slice[T]) Partition(pred func(T) bool) (matched, rest *Slice[T]) {
+	matched, rest = New[T](), New[T]()
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, v := range l.data {
+		if pred(v) {
+			matched.data = append(matched.data, v)
+		} else {
+			rest.data = append(rest.data, v)
+		}
+	}
+	return matched, rest
+}
+
+// Take returns a new list holding the first n items.
+func (l *Slice[T]) Take(n int) *Slice[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return New(l.data[:min(max(n, 0), len(l.data))]...)
+}
+
+// Drop returns a new list holding all but the first n items.
+func (l *Slice[T]) Drop(n int) *Slice[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return New(l.data[min(max(n, 0), len(l.data)):]...)
+}
+
+// TakeWhile returns a new list holding the leading items that satisfy pred.
+func (l *Slice[T]) TakeWhile(pred func(T) bool) *Slice[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return New(l.data[:l.prefixLen(pred)]...)
+}
+
+// DropWhile returns a new list holding the items after the leading items that satisfy pred.
+func (l *Slice[T]) DropWhile(pred func(T) bool) *Slice[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return New(l.data[l.prefixLen(pred):]...)
+}
+
+// prefixLen returns the length of the leading run of items satisfying pred.
+// The caller must hold the lock.
+func (l *Slice[T]) prefixLen(pred func(T) bool) int {
+	for i, v := range l.data {
+		if !pred(v) {
+			return i
+		}
+	}
+	return len(l.data)
+}
+
+// Page returns a copy of the items on the given 1-based page, along with the total
+// number of items, from a single consistent snapshot.
+// Pages out of range yield no items.
+func (l *Slice[T]) Page(page, perPage int) (items []T, total int) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	total = len(l.data)
+	if page < 1 || perPage < 1 || (page-1) >= (total+perPage-1)/perPage {
+		return []T{}, total
+	}
+	start := (page - 1) * perPage
+	end := min(start+perPage, total)
+	items = make([]T, end-start)
+	copy(items, l.data[start:end])
+	return items, total
+}
+
+// IndexFuncFrom returns the index of the first item at or after start satisfying pred,
+// or -1 if none do.
+func (l *Slice[T]) IndexFuncFrom(start int, pred func(T) bool) int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	start = max(start, 0)
+	if start >= len(l.data) {
+		return -1
+	}
+	if i := slices.IndexFunc(l.data[start:], pred); i >= 0 {
+		return start + i
+	}
+	return -1
+}
+
+// Truncate drops every item at index n and beyond.
+// It is a no-op if n is not less than the length.
+func (l *Slice[T]) Truncate(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n = max(n, 0)
+	if n >= len(l.data) {
+		return
+	}
+	clear(l.data[n:])
+	l.data = l.data[:n]
+}
+
+// Rotate rotates the items in place by n positions.
+// A positive n rotates left, moving the first n items to the end;
+// a negative n rotates right.
+func (l *Slice[T]) Rotate(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	size := len(l.data)
+	if size == 0 {
+		return
+	}
+	n %= size
+	if n < 0 {
+		n += size
+	}
+	if n == 0 {
+		return
+	}
+	slices.Reverse(l.data[:n])
+	slices.Reverse(l.data[n:])
+	slices.Reverse(l.data)
+}
@@ -0,0 +1,725 @@
+package slices
+
+import (
+	"cmp"
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSliceJSONRoundTrip(t *testing.T) {
+	type payload struct {
+		Items *Slice[int] `json:"items"`
+		Empty Slice[int]  `json:"empty"`
+	}
+
+	b, err := json.Marshal(&payload{Items: New(1, 2, 3)})
+	if err != nil {
+		t.Fatalf("marshal returned unexpected error: %v", err)
+	}
+	if got, want := string(b), `{"items":[1,2,3],"empty":[]}`; got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+
+	var out payload
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("unmarshal returned unexpected error: %v", err)
+	}
+	if got := out.Items.ToSlice(); len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+	if out.Empty.Len() != 0 {
+		t.Fatalf("expected empty list, got %v", out.Empty.ToSlice())
+	}
+}
+
+func TestSliceFIFO(t *testing.T) {
+	l := NewWithCap[int](4)
+	l.Append(1, 2, 3, 4)
+	if v, _ := l.PopFront(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+	if v, _ := l.PopFront(); v != 2 {
+		t.Fatalf("expected 2, got %d", v)
+	}
+	// The two freed slots at the front are reused without growing the storage.
+	l.PushFront(10, 20)
+	if got := l.ToSlice(); !slices.Equal(got, []int{10, 20, 3, 4}) {
+		t.Fatalf("unexpected items after PushFront: %v", got)
+	}
+	if c := l.Cap(); c != 4 {
+		t.Fatalf("expected PushFront to reuse the freed slots, got cap %d", c)
+	}
+	l.Append(5)
+	l.PushFront(0)
+	var got []int
+	for {
+		v, ok := l.PopFront()
+		if !ok {
+			break
+		}
+		got = append(got, v)
+		if v == 20 {
+			l.Append(6)
+		}
+	}
+	if !slices.Equal(got, []int{0, 10, 20, 3, 4, 5, 6}) {
+		t.Fatalf("unexpected FIFO order: %v", got)
+	}
+	if l.Len() != 0 {
+		t.Fatalf("expected an empty list, got %d items", l.Len())
+	}
+}
+
+func TestSliceRangeParallel(t *testing.T) {
+	for _, workers := range []int{-1, 0, 1, 3} {
+		const n = 50
+		l := New[int]()
+		for i := range n {
+			l.Append(i)
+		}
+		var (
+			visits        [n]atomic.Int32
+			running, peak atomic.Int32
+		)
+		l.RangeParallel(workers, func(i, item int) {
+			cur := running.Add(1)
+			for p := peak.Load(); cur > p && !peak.CompareAndSwap(p, cur); p = peak.Load() {
+			}
+			time.Sleep(100 * time.Microsecond)
+			running.Add(-1)
+			if item != i {
+				t.Errorf("expected item %d at index %d, got %d", i, i, item)
+			}
+			visits[i].Add(1)
+		})
+		for i := range visits {
+			if v := visits[i].Load(); v != 1 {
+				t.Fatalf("workers=%d: expected index %d to be visited once, got %d", workers, i, v)
+			}
+		}
+		if limit := max(workers, 1); int(peak.Load()) > limit {
+			t.Fatalf("workers=%d: expected at most %d concurrent calls, got %d", workers, limit, peak.Load())
+		}
+	}
+}
+
+func TestSliceRotate(t *testing.T) {
+	for _, tc := range []struct {
+		items []int
+		n     int
+		want  []int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 0, []int{1, 2, 3, 4, 5}},
+		{[]int{1, 2, 3, 4, 5}, 2, []int{3, 4, 5, 1, 2}},
+		{[]int{1, 2, 3, 4, 5}, -1, []int{5, 1, 2, 3, 4}},
+		{[]int{1, 2, 3, 4, 5}, 5, []int{1, 2, 3, 4, 5}},
+		{[]int{1, 2, 3, 4, 5}, -5, []int{1, 2, 3, 4, 5}},
+		{[]int{1, 2, 3, 4, 5}, 7, []int{3, 4, 5, 1, 2}},
+		{[]int{1, 2, 3, 4, 5}, -7, []int{4, 5, 1, 2, 3}},
+		{[]int{}, 3, []int{}},
+	} {
+		l := New(tc.items...)
+		l.Rotate(tc.n)
+		if got := l.ToSlice(); !slices.Equal(got, tc.want) {
+			t.Fatalf("Rotate(%d) of %v: expected %v, got %v", tc.n, tc.items, tc.want, got)
+		}
+	}
+}
+
+func TestSliceSampleAndPick(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	l := New(items...)
+
+	got := l.SampleRand(rand.New(rand.NewSource(1)), 4)
+	if len(got) != 4 {
+		t.Fatalf("expected 4 items, got %v", got)
+	}
+	seen := make(map[int]bool)
+	for _, v := range got {
+		if seen[v] || !slices.Contains(items, v) {
+			t.Fatalf("expected distinct items from the list, got %v", got)
+		}
+		seen[v] = true
+	}
+	if again := l.SampleRand(rand.New(rand.NewSource(1)), 4); !slices.Equal(again, got) {
+		t.Fatalf("expected the same seed to give the same sample, got %v and %v", got, again)
+	}
+	if got := l.SampleRand(rand.New(rand.NewSource(2)), -1); len(got) != 0 {
+		t.Fatalf("expected no items for n<0, got %v", got)
+	}
+	all := l.SampleRand(rand.New(rand.NewSource(3)), 20)
+	slices.Sort(all)
+	if !slices.Equal(all, items) {
+		t.Fatalf("expected n>len to return a permutation of the list, got %v", all)
+	}
+	if len(l.Sample(3)) != 3 {
+		t.Fatal("expected Sample to return 3 items")
+	}
+
+	r := rand.New(rand.NewSource(4))
+	for range 10 {
+		if v, ok := l.PickRand(r); !ok || !slices.Contains(items, v) {
+			t.Fatalf("expected an item from the list, got %d (ok=%v)", v, ok)
+		}
+	}
+	if _, ok := New[int]().Pick(); ok {
+		t.Fatal("expected Pick on an empty list to fail")
+	}
+	if got := l.ToSlice(); !slices.Equal(got, items) {
+		t.Fatalf("expected the list to be unchanged, got %v", got)
+	}
+}
+
+func TestSlicePage(t *testing.T) {
+	l := New(1, 2, 3, 4, 5, 6, 7)
+	for _, tc := range []struct {
+		page, perPage int
+		want          []int
+	}{
+		{0, 3, []int{}},
+		{1, 0, []int{}},
+		{1, 3, []int{1, 2, 3}},
+		{2, 3, []int{4, 5, 6}},
+		{3, 3, []int{7}},
+		{4, 3, []int{}},
+	} {
+		items, total := l.Page(tc.page, tc.perPage)
+		if total != 7 || !slices.Equal(items, tc.want) {
+			t.Fatalf("Page(%d, %d): expected %v of 7, got %v of %d", tc.page, tc.perPage, tc.want, items, total)
+		}
+	}
+	items, total := New[int]().Page(1, 10)
+	if items == nil || len(items) != 0 || total != 0 {
+		t.Fatalf("expected an empty non-nil page of 0, got %#v of %d", items, total)
+	}
+}
+
+func TestSliceInsert(t *testing.T) {
+	l := New(2, 3)
+	if !l.Insert(0, 1) || !l.Insert(l.Len(), 4, 5) || !l.Insert(2, 9) {
+		t.Fatal("expected in-bounds inserts to succeed")
+	}
+	if l.Insert(-1, 0) || l.Insert(l.Len()+1, 0) {
+		t.Fatal("expected out-of-bounds inserts to fail")
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 9, 3, 4, 5}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+}
+
+func TestSliceRemoveFunc(t *testing.T) {
+	words := []string{"a", "bb", "c", "dd", "ee", "f"}
+	l := New(words...)
+	backing := l.data[:cap(l.data)]
+	long := func(s string) bool { return len(s) > 1 }
+	if n := l.RemoveFunc(long); n != 3 {
+		t.Fatalf("expected 3 removals, got %d", n)
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []string{"a", "c", "f"}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+	for i, v := range backing[l.Len():] {
+		if v != "" {
+			t.Fatalf("expected the freed tail to be cleared, got %q at %d", v, l.Len()+i)
+		}
+	}
+	if n := l.RemoveFunc(long); n != 0 {
+		t.Fatalf("expected no more removals, got %d", n)
+	}
+}
+
+func TestSliceChunk(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+	if l.Chunk(0) != nil || l.Chunk(-1) != nil {
+		t.Fatal("expected nil for a non-positive size")
+	}
+	chunks := l.Chunk(2)
+	var got [][]int
+	for _, c := range chunks {
+		got = append(got, c.ToSlice())
+	}
+	if !slices.EqualFunc(got, [][]int{{1, 2}, {3, 4}, {5}}, slices.Equal[[]int]) {
+		t.Fatalf("unexpected chunks: %v", got)
+	}
+	chunks[0].Append(99)
+	if next := chunks[1].ToSlice(); !slices.Equal(next, []int{3, 4}) {
+		t.Fatalf("expected appending to a chunk not to overwrite the next one, got %v", next)
+	}
+	if items := l.ToSlice(); !slices.Equal(items, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected the list to be unchanged, got %v", items)
+	}
+}
+
+func TestSliceDedupeAndUnique(t *testing.T) {
+	l := New(3, 1, 3, 2, 1, 2)
+	l.Dedupe(func(a, b int) bool { return a == b })
+	if got := l.ToSlice(); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Fatalf("expected first-seen order from Dedupe, got %v", got)
+	}
+	s := New("b", "a", "b", "c", "a")
+	Unique(s)
+	if got := s.ToSlice(); !slices.Equal(got, []string{"b", "a", "c"}) {
+		t.Fatalf("expected first-seen order from Unique, got %v", got)
+	}
+}
+
+func TestSliceRemoveRange(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+	if l.RemoveRange(3, 2) || l.RemoveRange(0, 6) || l.RemoveRange(-1, 2) {
+		t.Fatal("expected out-of-bounds ranges to fail")
+	}
+	if !l.RemoveRange(2, 2) || l.Len() != 5 {
+		t.Fatal("expected an empty range to succeed without removing anything")
+	}
+	if !l.RemoveRange(1, 3) {
+		t.Fatal("expected an in-bounds range to succeed")
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 4, 5}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+}
+
+func TestSliceTruncate(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+	l.Truncate(5)
+	l.Truncate(9)
+	if l.Len() != 5 {
+		t.Fatalf("expected n>=Len to be a no-op, got %v", l.ToSlice())
+	}
+	l.Truncate(2)
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+	l.Truncate(-1)
+	if l.Len() != 0 {
+		t.Fatalf("expected n<0 to drop every item, got %v", l.ToSlice())
+	}
+}
+
+func TestSliceIndexFuncFrom(t *testing.T) {
+	l := New(1, 2, 3, 2)
+	two := func(v int) bool { return v == 2 }
+	for _, tc := range []struct{ start, want int }{
+		{-5, 1},
+		{0, 1},
+		{2, 3},
+		{4, -1},
+		{10, -1},
+	} {
+		if got := l.IndexFuncFrom(tc.start, two); got != tc.want {
+			t.Fatalf("IndexFuncFrom(%d): expected %d, got %d", tc.start, tc.want, got)
+		}
+	}
+}
+
+func TestSliceJoin(t *testing.T) {
+	if got := New("a", "b", "c").Join(",", nil); got != "a,b,c" {
+		t.Fatalf("unexpected string join: %q", got)
+	}
+	if got := New(1, 2, 3).Join("-", nil); got != "1-2-3" {
+		t.Fatalf("unexpected fmt.Sprint join: %q", got)
+	}
+	quoted := New("x", "y").Join(" ", strconv.Quote)
+	if quoted != `"x" "y"` {
+		t.Fatalf("unexpected formatted join: %q", quoted)
+	}
+	if got := New[int]().Join(",", nil); got != "" {
+		t.Fatalf("expected an empty string, got %q", got)
+	}
+}
+
+func TestSliceTakeAndDrop(t *testing.T) {
+	l := New(1, 2, 3)
+	less := func(n int) func(int) bool { return func(v int) bool { return v < n } }
+	for name, tc := range map[string]struct {
+		got  *Slice[int]
+		want []int
+	}{
+		"Take(-1)":        {l.Take(-1), []int{}},
+		"Take(2)":         {l.Take(2), []int{1, 2}},
+		"Take(5)":         {l.Take(5), []int{1, 2, 3}},
+		"Drop(-1)":        {l.Drop(-1), []int{1, 2, 3}},
+		"Drop(1)":         {l.Drop(1), []int{2, 3}},
+		"Drop(5)":         {l.Drop(5), []int{}},
+		"TakeWhile(<2)":   {l.TakeWhile(less(2)), []int{1}},
+		"TakeWhile(all)":  {l.TakeWhile(less(10)), []int{1, 2, 3}},
+		"DropWhile(<2)":   {l.DropWhile(less(2)), []int{2, 3}},
+		"DropWhile(all)":  {l.DropWhile(less(10)), []int{}},
+		"DropWhile(none)": {l.DropWhile(less(0)), []int{1, 2, 3}},
+	} {
+		if got := tc.got.ToSlice(); !slices.Equal(got, tc.want) {
+			t.Fatalf("%s: expected %v, got %v", name, tc.want, got)
+		}
+	}
+}
+
+func TestSliceBinarySearchFunc(t *testing.T) {
+	l := New(1, 3, 5, 7)
+	for _, tc := range []struct {
+		target, want int
+		found        bool
+	}{
+		{5, 2, true},
+		{1, 0, true},
+		{0, 0, false},
+		{4, 2, false},
+		{8, 4, false},
+	} {
+		i, found := l.BinarySearchFunc(tc.target, cmp.Compare[int])
+		if i != tc.want || found != tc.found {
+			t.Fatalf("search %d: expected (%d, %v), got (%d, %v)", tc.target, tc.want, tc.found, i, found)
+		}
+	}
+}
+
+func TestSliceSubSlice(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+	for _, r := range [][2]int{{-1, 2}, {3, 2}, {0, 6}} {
+		if _, ok := l.SubSlice(r[0], r[1]); ok {
+			t.Fatalf("expected SubSlice(%d, %d) to fail", r[0], r[1])
+		}
+	}
+	if sub, ok := l.SubSlice(5, 5); !ok || sub.Len() != 0 {
+		t.Fatal("expected an empty range at the end to succeed")
+	}
+	sub, ok := l.SubSlice(1, 3)
+	if !ok || !slices.Equal(sub.ToSlice(), []int{2, 3}) {
+		t.Fatalf("unexpected sub-slice: %v", sub.ToSlice())
+	}
+	sub.Set(0, 99)
+	sub.Append(100)
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected the sub-slice to be an independent copy, got %v", got)
+	}
+}
+
+func TestSliceShuffle(t *testing.T) {
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+	a, b := New(items...), New(items...)
+	a.ShuffleRand(rand.New(rand.NewSource(42)))
+	b.ShuffleRand(rand.New(rand.NewSource(42)))
+	if !a.Equal(b, func(x, y int) bool { return x == y }) {
+		t.Fatalf("expected the same seed to give the same order, got %v and %v", a.ToSlice(), b.ToSlice())
+	}
+	if slices.Equal(a.ToSlice(), items) {
+		t.Fatal("expected the items to be reordered")
+	}
+	c := New(items...)
+	c.Shuffle()
+	for _, l := range []*Slice[int]{a, c} {
+		got := l.ToSlice()
+		slices.Sort(got)
+		if !slices.Equal(got, items) {
+			t.Fatalf("expected a permutation of the input, got %v", l.ToSlice())
+		}
+	}
+}
+
+func TestSliceStackOps(t *testing.T) {
+	l := New[int]()
+	if _, ok := l.Pop(); ok {
+		t.Fatal("expected Pop on an empty list to fail")
+	}
+	if _, ok := l.Peek(); ok {
+		t.Fatal("expected Peek on an empty list to fail")
+	}
+	l.Push(1, 2).Push(3)
+	if v, ok := l.Peek(); !ok || v != 3 {
+		t.Fatalf("expected 3 on top, got %d", v)
+	}
+	for want := 3; want >= 1; want-- {
+		if v, ok := l.Pop(); !ok || v != want {
+			t.Fatalf("expected %d, got %d (ok=%v)", want, v, ok)
+		}
+	}
+	if _, ok := l.Pop(); ok || l.Len() != 0 {
+		t.Fatal("expected the list to be empty")
+	}
+}
+
+func TestSliceIndexAndContains(t *testing.T) {
+	l := New("a", "b", "c", "b")
+	isB := func(s string) bool { return s == "b" }
+	isZ := func(s string) bool { return s == "z" }
+	if l.IndexFunc(isB) != 1 || l.IndexFunc(isZ) != -1 {
+		t.Fatal("unexpected IndexFunc result")
+	}
+	if !l.ContainsFunc(isB) || l.ContainsFunc(isZ) {
+		t.Fatal("unexpected ContainsFunc result")
+	}
+	if IndexOf(l, "c") != 2 || IndexOf(l, "z") != -1 {
+		t.Fatal("unexpected IndexOf result")
+	}
+	if !Contains(l, "a") || Contains(l, "z") || Contains(New[string](), "a") {
+		t.Fatal("unexpected Contains result")
+	}
+}
+
+func TestSliceSort(t *testing.T) {
+	l := New(3, 1, 4, 1, 5, 9, 2, 6)
+	l.Sort(func(a, b int) bool { return a > b })
+	if got := l.ToSlice(); !slices.Equal(got, []int{9, 6, 5, 4, 3, 2, 1, 1}) {
+		t.Fatalf("unexpected order from Sort: %v", got)
+	}
+	s := New("c", "a", "b")
+	SortOrdered(s)
+	if got := s.ToSlice(); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Fatalf("unexpected order from SortOrdered: %v", got)
+	}
+}
+
+func TestSliceSortStable(t *testing.T) {
+	type row struct{ key, seq int }
+	l := New(row{2, 0}, row{1, 1}, row{2, 2}, row{1, 3}, row{0, 4})
+	byKey := func(a, b row) bool { return a.key < b.key }
+	if l.IsSorted(byKey) {
+		t.Fatal("expected the rows not to be sorted yet")
+	}
+	l.SortStable(byKey)
+	want := []row{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}}
+	if got := l.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("expected equal keys to keep their order, got %v", got)
+	}
+	if !l.IsSorted(byKey) {
+		t.Fatal("expected the rows to be sorted")
+	}
+}
+
+func TestSliceReverse(t *testing.T) {
+	l := New(1, 2, 3, 4)
+	l.Reverse()
+	if got := l.ToSlice(); !slices.Equal(got, []int{4, 3, 2, 1}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+	empty := New[int]()
+	empty.Reverse()
+	if empty.Len() != 0 {
+		t.Fatal("expected an empty list to stay empty")
+	}
+}
+
+func TestSliceFilterAndKeep(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	l := New(1, 2, 3, 4, 5, 6)
+	if got := l.Filter(even).ToSlice(); !slices.Equal(got, []int{2, 4, 6}) {
+		t.Fatalf("unexpected filtered items: %v", got)
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4, 5, 6}) {
+		t.Fatalf("expected Filter to leave the list unchanged, got %v", got)
+	}
+	l.Keep(even)
+	if got := l.ToSlice(); !slices.Equal(got, []int{2, 4, 6}) {
+		t.Fatalf("unexpected items after Keep: %v", got)
+	}
+	l.Keep(func(int) bool { return false })
+	if l.Len() != 0 {
+		t.Fatalf("expected Keep to remove everything, got %v", l.ToSlice())
+	}
+}
+
+func TestSliceExtendAndConcat(t *testing.T) {
+	l := New(1, 2)
+	l.Extend(New(3))
+	l.Extend(l)
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 1, 2, 3}) {
+		t.Fatalf("unexpected items after self-extension: %v", got)
+	}
+	c := Concat(New(1), New[int](), New(2, 3))
+	if got := c.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("unexpected concatenation: %v", got)
+	}
+	if Concat[int]().Len() != 0 {
+		t.Fatal("expected an empty concatenation")
+	}
+}
+
+func TestSliceIterators(t *testing.T) {
+	l := New(1, 2, 3, 4)
+	var seen []int
+	for i, v := range l.All() {
+		if i == 2 {
+			break
+		}
+		seen = append(seen, v)
+	}
+	if !slices.Equal(seen, []int{1, 2}) {
+		t.Fatalf("expected iteration to stop at break, got %v", seen)
+	}
+
+	seen = nil
+	for v := range l.Values() {
+		// Appending while iterating does not extend the snapshot being iterated.
+		l.Append(v * 10)
+		seen = append(seen, v)
+	}
+	if !slices.Equal(seen, []int{1, 2, 3, 4}) || l.Len() != 8 {
+		t.Fatalf("expected iteration over a snapshot, got %v with %d items", seen, l.Len())
+	}
+}
+
+func TestSliceCapacity(t *testing.T) {
+	l := NewWithCap[int](8)
+	if l.Len() != 0 || l.Cap() != 8 {
+		t.Fatalf("expected an empty list with cap 8, got len %d cap %d", l.Len(), l.Cap())
+	}
+	if c := NewWithCap[int](-1).Cap(); c != 0 {
+		t.Fatalf("expected a negative capacity to be treated as 0, got %d", c)
+	}
+	l.Append(1)
+	l.Grow(0)
+	l.Grow(-1)
+	if l.Cap() != 8 {
+		t.Fatalf("expected non-positive Grow to be a no-op, got cap %d", l.Cap())
+	}
+	l.Grow(20)
+	if l.Cap() < 21 || l.Len() != 1 {
+		t.Fatalf("expected room for 20 more items, got len %d cap %d", l.Len(), l.Cap())
+	}
+}
+
+func TestSliceShrink(t *testing.T) {
+	l := NewWithCap[int](100)
+	l.Append(1, 2, 3)
+	l.Shrink()
+	if l.Cap() != 3 {
+		t.Fatalf("expected capacity to drop to the length, got %d", l.Cap())
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("expected the items to be kept, got %v", got)
+	}
+}
+
+func TestSliceSwap(t *testing.T) {
+	l := New(1, 2, 3)
+	if !l.Swap(0, 2) {
+		t.Fatal("expected an in-bounds swap to succeed")
+	}
+	for _, ij := range [][2]int{{-1, 0}, {0, 3}, {3, 0}, {0, -1}} {
+		if l.Swap(ij[0], ij[1]) {
+			t.Fatalf("expected Swap(%d, %d) to fail", ij[0], ij[1])
+		}
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+}
+
+func TestSliceMinMax(t *testing.T) {
+	type item struct {
+		v  int
+		id string
+	}
+	less := func(a, b item) bool { return a.v < b.v }
+	l := New(item{2, "a"}, item{1, "b"}, item{1, "c"}, item{2, "d"})
+	if m, ok := l.MinFunc(less); !ok || m.id != "b" {
+		t.Fatalf("expected the first minimal item, got %v", m)
+	}
+	if m, ok := l.MaxFunc(less); !ok || m.id != "a" {
+		t.Fatalf("expected the first maximal item, got %v", m)
+	}
+	if _, ok := New[item]().MinFunc(less); ok {
+		t.Fatal("expected MinFunc on an empty list to fail")
+	}
+	if _, ok := New[item]().MaxFunc(less); ok {
+		t.Fatal("expected MaxFunc on an empty list to fail")
+	}
+
+	n := New(3, 1, 2)
+	if v, ok := Min(n); !ok || v != 1 {
+		t.Fatalf("expected min 1, got %d", v)
+	}
+	if v, ok := Max(n); !ok || v != 3 {
+		t.Fatalf("expected max 3, got %d", v)
+	}
+	if _, ok := Min(New[int]()); ok {
+		t.Fatal("expected Min on an empty list to fail")
+	}
+	if _, ok := Max(New[int]()); ok {
+		t.Fatal("expected Max on an empty list to fail")
+	}
+}
+
+func TestRepeatAndFill(t *testing.T) {
+	if got := Repeat("x", 3).ToSlice(); !slices.Equal(got, []string{"x", "x", "x"}) {
+		t.Fatalf("unexpected repeated items: %v", got)
+	}
+	for _, n := range []int{0, -2} {
+		if l := Repeat(1, n); l.Len() != 0 {
+			t.Fatalf("expected Repeat(%d) to be empty, got %v", n, l.ToSlice())
+		}
+	}
+	l := New(1, 2, 3)
+	l.Fill(0)
+	if got := l.ToSlice(); !slices.Equal(got, []int{0, 0, 0}) {
+		t.Fatalf("unexpected items after Fill: %v", got)
+	}
+}
+
+func TestSliceEqual(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+	l := New(1, 2)
+	if !l.Equal(New(1, 2), eq) || !l.Equal(l, eq) {
+		t.Fatal("expected equal lists to be equal")
+	}
+	if l.Equal(New(1, 2, 3), eq) || l.Equal(New(2, 1), eq) || l.Equal(New[int](), eq) {
+		t.Fatal("expected lists with different items to differ")
+	}
+}
+
+func TestSliceFirstAndLast(t *testing.T) {
+	if _, ok := New[int]().First(); ok {
+		t.Fatal("expected First on an empty list to fail")
+	}
+	if _, ok := New[int]().Last(); ok {
+		t.Fatal("expected Last on an empty list to fail")
+	}
+	l := New(1, 2, 3)
+	if v, ok := l.First(); !ok || v != 1 {
+		t.Fatalf("expected first 1, got %d", v)
+	}
+	if v, ok := l.Last(); !ok || v != 3 {
+		t.Fatalf("expected last 3, got %d", v)
+	}
+}
+
+func TestSlicePredicates(t *testing.T) {
+	gt := func(n int) func(int) bool { return func(v int) bool { return v > n } }
+	odd := func(v int) bool { return v%2 == 1 }
+	l := New(1, 2, 3)
+	if !l.Any(gt(2)) || l.Any(gt(5)) {
+		t.Fatal("unexpected Any result")
+	}
+	if !l.Every(gt(0)) || l.Every(gt(1)) {
+		t.Fatal("unexpected Every result")
+	}
+	if n := l.Count(odd); n != 2 {
+		t.Fatalf("expected 2 odd items, got %d", n)
+	}
+	empty := New[int]()
+	if empty.Any(odd) || !empty.Every(odd) || empty.Count(odd) != 0 {
+		t.Fatal("unexpected predicate results on an empty list")
+	}
+}
+
+func TestSlicePartition(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+	matched, rest := l.Partition(func(v int) bool { return v%2 == 1 })
+	if !slices.Equal(matched.ToSlice(), []int{1, 3, 5}) || !slices.Equal(rest.ToSlice(), []int{2, 4}) {
+		t.Fatalf("unexpected partition: %v, %v", matched.ToSlice(), rest.ToSlice())
+	}
+	if l.Len() != 5 {
+		t.Fatal("expected the list to be unchanged")
+	}
+	matched, rest = New[int]().Partition(func(int) bool { return true })
+	if matched.Len() != 0 || rest.Len() != 0 {
+		t.Fatal("expected an empty list to give two empty lists")
+	}
+}
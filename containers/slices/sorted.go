@@ -0,0 +1,176 @@
+package slices
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// SortedSlice is a thread-safe generic list that keeps its items ordered by less.
+// Items are placed with a binary search on insert, so lookups are O(log n).
+type SortedSlice[T any] struct {
+	mu   sync.RWMutex
+	less func(a, b T) bool
+	data []T
+}
+
+// NewSorted creates a new SortedSlice ordered by less, with optional initial elements.
+func NewSorted[T any](less func(a, b T) bool, items ...T) *SortedSlice[T] {
+	l := &SortedSlice[T]{less: less, data: slices.Clone(items)}
+	sort.SliceStable(l.data, func(i, j int) bool { return less(l.data[i], l.data[j]) })
+	return l
+}
+
+// upperBound returns the index of the first item greater than item.
+// The caller must hold the lock.
+func (l *SortedSlice[T]) upperBound(item T) int {
+	return sort.Search(len(l.data), func(i int) bool { return l.less(item, l.data[i]) })
+}
+
+// lowerBound returns the index of the first item not less than item.
+// The caller must hold the lock.
+func (l *SortedSlice[T]) lowerBound(item T) int {
+	return sort.Search(len(l.data), func(i int) bool { return !l.less(l.data[i], item) })
+}
+
+// Len returns the number of elements in the list.
+func (l *SortedSlice[T]) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.data)
+}
+
+// Clear removes all elements from the list.
+func (l *SortedSlice[T]) Clear() {
+	l.mu.Lock()
+	clear(l.data)
+	l.data = l.data[:0]
+	l.mu.Unlock()
+}
+
+// Add inserts items at their ordered positions.
+// Equal items are kept in insertion order.
+func (l *SortedSlice[T]) Add(items ...T) *SortedSlice[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, item := range items {
+		l.data = slices.Insert(l.data, l.upperBound(item), item)
+	}
+	return l
+}
+
+// Remove removes the first item equal to item and reports whether one was found.
+func (l *SortedSlice[T]) Remove(item T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	i := l.lowerBound(item)
+	if i >= len(l.data) || l.less(item, l.data[i]) {
+		return false
+	}
+	l.data = slices.Delete(l.data, i, i+1)
+	return true
+}
+
+// RemoveAt removes and returns the item at index i.
+// It returns false if i is out of bounds.
+func (l *SortedSlice[T]) RemoveAt(i int) (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if i < 0 || i >= len(l.data) {
+		var zero T
+		return zero, false
+	}
+	v := l.data[i]
+	l.data = slices.Delete(l.data, i, i+1)
+	return v, true
+}
+
+// IndexOf returns the index of the first item equal to item, or -1 if not present.
+func (l *SortedSlice[T]) IndexOf(item T) int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	i := l.lowerBound(item)
+	if i >= len(l.data) || l.less(item, l.data[i]) {
+		return -1
+	}
+	return i
+}
+
+// Contains reports whether an item equal to item is present.
+func (l *SortedSlice[T]) Contains(item T) bool {
+	return l.IndexOf(item) >= 0
+}
+
+// Get returns the item at index i.
+// It returns false if i is out of bounds.
+func (l *SortedSlice[T]) Get(i int) (T, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if i < 0 || i >= len(l.data) {
+		var zero T
+		return zero, false
+	}
+	return l.data[i], true
+}
+
+// First returns the smallest item.
+// It returns false if the list is empty.
+func (l *SortedSlice[T]) First() (T, bool) {
+	return l.Get(0)
+}
+
+// Last returns the largest item.
+// It returns false if the list is empty.
+func (l *SortedSlice[T]) Last() (T, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if len(l.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return l.data[len(l.data)-1], true
+}
+
+// ToSlice returns a copy of the items in order.
+func (l *SortedSlice[T]) ToSlice() []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	cpy := make([]T, len(l.data))
+	copy(cpy, l.data)
+	return cpy
+}
+
+// Range iterates over a snapshot of the list in order.
+// The callback receives the index and item. If it returns false, iteration stops.
+func (l *SortedSlice[T]) Range(f func(index int, item T) bool) {
+	for i, v := range l.ToSlice() {
+		if !f(i, v) {
+			break
+		}
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (l *SortedSlice[T]) MarshalJSON() ([]byte, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.data == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(l.data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Decoded items replace the contents of the list and are put in order.
+func (l *SortedSlice[T]) UnmarshalJSON(b []byte) error {
+	var data []T
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sort.SliceStable(data, func(i, j int) bool { return l.less(data[i], data[j]) })
+	l.data = data
+	return nil
+}
@@ -0,0 +1,32 @@
+package slices
+
+import "testing"
+
+func TestSortedSliceKeepsOrder(t *testing.T) {
+	l := NewSorted(func(a, b int) bool { return a < b }, 5, 1, 3)
+	l.Add(4, 0, 3)
+
+	want := []int{0, 1, 3, 3, 4, 5}
+	got := l.ToSlice()
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+
+	if i := l.IndexOf(3); i != 2 {
+		t.Fatalf("expected first 3 at index 2, got %d", i)
+	}
+	if l.Contains(2) {
+		t.Fatalf("expected 2 to be absent")
+	}
+	if !l.Remove(3) || l.Len() != 5 {
+		t.Fatalf("expected a single 3 to be removed, got %v", l.ToSlice())
+	}
+	if l.Remove(2) {
+		t.Fatalf("expected removing an absent item to fail")
+	}
+}
@@ -0,0 +1,142 @@
+package slices
+
+import (
+	"math"
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+const (
+	sparsePageBits = 6
+	sparsePageSize = 1 << sparsePageBits
+)
+
+type sparsePage[V any] struct {
+	values  [sparsePageSize]V
+	present uint64
+}
+
+// SparseArray is a thread-safe array indexed by arbitrary int64 indices, for
+// keys such as sequence numbers that are sparse but clustered. Values live in
+// fixed-size pages found by index, so lookups avoid hashing every key and Range
+// visits indices in ascending order.
+type SparseArray[V any] struct {
+	mu     sync.RWMutex
+	pages  map[int64]*sparsePage[V]
+	keys   []int64 // sorted page keys
+	length int
+}
+
+// NewSparse creates an empty SparseArray.
+func NewSparse[V any]() *SparseArray[V] {
+	return &SparseArray[V]{pages: make(map[int64]*sparsePage[V])}
+}
+
+func sparseLocate(i int64) (int64, uint) {
+	return i >> sparsePageBits, uint(i & (sparsePageSize - 1))
+}
+
+// Get returns the value at index i.
+func (a *SparseArray[V]) Get(i int64) (V, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	key, off := sparseLocate(i)
+	if p, ok := a.pages[key]; ok && p.present&(1<<off) != 0 {
+		return p.values[off], true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has reports whether index i holds a value.
+func (a *SparseArray[V]) Has(i int64) bool {
+	_, ok := a.Get(i)
+	return ok
+}
+
+// Set sets the value at index i.
+func (a *SparseArray[V]) Set(i int64, value V) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	key, off := sparseLocate(i)
+	p, ok := a.pages[key]
+	if !ok {
+		p = &sparsePage[V]{}
+		a.pages[key] = p
+		j, _ := slices.BinarySearch(a.keys, key)
+		a.keys = slices.Insert(a.keys, j, key)
+	}
+	if p.present&(1<<off) == 0 {
+		p.present |= 1 << off
+		a.length++
+	}
+	p.values[off] = value
+}
+
+// Delete removes the value at index i and reports whether it was present.
+// Pages left empty are released.
+func (a *SparseArray[V]) Delete(i int64) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	key, off := sparseLocate(i)
+	p, ok := a.pages[key]
+	if !ok || p.present&(1<<off) == 0 {
+		return false
+	}
+	var zero V
+	p.values[off] = zero
+	p.present &^= 1 << off
+	a.length--
+	if p.present == 0 {
+		delete(a.pages, key)
+		j, _ := slices.BinarySearch(a.keys, key)
+		a.keys = slices.Delete(a.keys, j, j+1)
+	}
+	return true
+}
+
+// Len returns the number of values.
+func (a *SparseArray[V]) Len() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.length
+}
+
+// Clear removes all values.
+func (a *SparseArray[V]) Clear() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	clear(a.pages)
+	a.keys = nil
+	a.length = 0
+}
+
+// Range calls f for every index and value in ascending index order.
+// If f returns false, iteration stops. f must not modify the array.
+func (a *SparseArray[V]) Range(f func(i int64, value V) bool) {
+	a.RangeFrom(math.MinInt64, f)
+}
+
+// RangeFrom calls f in ascending index order for every index >= from.
+// If f returns false, iteration stops. f must not modify the array.
+func (a *SparseArray[V]) RangeFrom(from int64, f func(i int64, value V) bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	fromKey, fromOff := sparseLocate(from)
+	start, _ := slices.BinarySearch(a.keys, fromKey)
+	for _, key := range a.keys[start:] {
+		p := a.pages[key]
+		present := p.present
+		if key == fromKey {
+			present &^= 1<<fromOff - 1
+		}
+		for present != 0 {
+			off := bits.TrailingZeros64(present)
+			if !f(key<<sparsePageBits|int64(off), p.values[off]) {
+				return
+			}
+			present &= present - 1
+		}
+	}
+}
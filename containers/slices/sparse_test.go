@@ -0,0 +1,45 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSparseArray(t *testing.T) {
+	a := NewSparse[string]()
+	for _, i := range []int64{1 << 40, -5, 3, 64, 63} {
+		a.Set(i, "v")
+	}
+	a.Set(3, "three")
+	if v, ok := a.Get(3); !ok || v != "three" {
+		t.Fatalf("unexpected Get: %q, %v", v, ok)
+	}
+	if a.Has(4) || a.Len() != 5 {
+		t.Fatalf("unexpected state: Has(4)=%v Len=%d", a.Has(4), a.Len())
+	}
+
+	var got []int64
+	a.Range(func(i int64, _ string) bool {
+		got = append(got, i)
+		return true
+	})
+	if want := []int64{-5, 3, 63, 64, 1 << 40}; !slices.Equal(got, want) {
+		t.Fatalf("expected indices %v, got %v", want, got)
+	}
+
+	got = got[:0]
+	a.RangeFrom(5, func(i int64, _ string) bool {
+		got = append(got, i)
+		return len(got) < 2
+	})
+	if want := []int64{63, 64}; !slices.Equal(got, want) {
+		t.Fatalf("expected indices %v from 5, got %v", want, got)
+	}
+
+	if !a.Delete(64) || a.Delete(64) || a.Len() != 4 {
+		t.Fatalf("unexpected Delete results")
+	}
+	if len(a.keys) != 3 {
+		t.Fatalf("expected empty page to be released, got %d pages", len(a.keys))
+	}
+}
@@ -0,0 +1,69 @@
+package stacks
+
+import "sync/atomic"
+
+type lockFreeNode[T any] struct {
+	value T
+	next  *lockFreeNode[T]
+}
+
+// LockFreeStack is a lock-free generic LIFO stack, a Treiber stack that swaps the
+// top node with a single CAS. Nodes are never reused while reachable, because the
+// garbage collector keeps them alive, so the classic ABA problem cannot occur.
+type LockFreeStack[T any] struct {
+	top    atomic.Pointer[lockFreeNode[T]]
+	length atomic.Int64
+}
+
+// NewLockFree creates a LockFreeStack with optional initial items, the last on top.
+func NewLockFree[T any](items ...T) *LockFreeStack[T] {
+	s := &LockFreeStack[T]{}
+	for _, item := range items {
+		s.Push(item)
+	}
+	return s
+}
+
+// Push adds item to the top of the stack.
+func (s *LockFreeStack[T]) Push(item T) {
+	n := &lockFreeNode[T]{value: item}
+	for {
+		n.next = s.top.Load()
+		if s.top.CompareAndSwap(n.next, n) {
+			s.length.Add(1)
+			return
+		}
+	}
+}
+
+// Pop removes and returns the item at the top of the stack.
+// It returns false if the stack is empty.
+func (s *LockFreeStack[T]) Pop() (T, bool) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var zero T
+			return zero, false
+		}
+		if s.top.CompareAndSwap(top, top.next) {
+			s.length.Add(-1)
+			return top.value, true
+		}
+	}
+}
+
+// Peek returns the item at the top of the stack without removing it.
+// It returns false if the stack is empty.
+func (s *LockFreeStack[T]) Peek() (T, bool) {
+	if top := s.top.Load(); top != nil {
+		return top.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Len returns the number of items in the stack. It is only a snapshot while
+// other goroutines are using the stack.
+func (s *LockFreeStack[T]) Len() int {
+	return int(max(s.length.Load(), 0))
+}
@@ -0,0 +1,63 @@
+package stacks
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLockFreeStack(t *testing.T) {
+	s := NewLockFree(1, 2, 3)
+	if v, ok := s.Peek(); !ok || v != 3 {
+		t.Fatalf("expected 3 on top, got %d (ok=%v)", v, ok)
+	}
+	for want := 3; want >= 1; want-- {
+		if v, ok := s.Pop(); !ok || v != want {
+			t.Fatalf("expected %d, got %d (ok=%v)", want, v, ok)
+		}
+	}
+	if _, ok := s.Pop(); ok || s.Len() != 0 {
+		t.Fatalf("expected stack to be empty")
+	}
+}
+
+func TestLockFreeStackConcurrent(t *testing.T) {
+	s := NewLockFree[int]()
+	const workers, items = 8, 1000
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < items; i++ {
+				s.Push(i)
+				if i%2 == 1 {
+					s.Pop()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if got := s.Len(); got != workers*items/2 {
+		t.Fatalf("expected %d items, got %d", workers*items/2, got)
+	}
+}
+
+func BenchmarkLockFreeStack(b *testing.B) {
+	s := NewLockFree[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			s.Push(i)
+			s.Pop()
+		}
+	})
+}
+
+func BenchmarkStack(b *testing.B) {
+	s := New[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			s.Push(i)
+			s.Pop()
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package stacks
+
+import "sync"
+
+// Stack is a thread-safe generic LIFO stack with optional bounded capacity.
+// Push, Pop and Peek are O(1).
+type Stack[T any] struct {
+	mu       sync.RWMutex
+	data     []T
+	capacity int
+}
+
+// New creates an unbounded Stack with optional initial items, the last on top.
+func New[T any](items ...T) *Stack[T] {
+	d := make([]T, 0, len(items))
+	d = append(d, items...)
+	return &Stack[T]{data: d}
+}
+
+// NewBounded creates an empty Stack that holds at most capacity items.
+// A capacity less than 1 is treated as 1; use New for an unbounded stack.
+func NewBounded[T any](capacity int) *Stack[T] {
+	capacity = max(capacity, 1)
+	return &Stack[T]{data: make([]T, 0, capacity), capacity: capacity}
+}
+
+// Push adds item to the top of the stack.
+// It returns false if the stack is bounded and full.
+func (s *Stack[T]) Push(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.capacity > 0 && len(s.data) >= s.capacity {
+		return false
+	}
+	s.data = append(s.data, item)
+	return true
+}
+
+// Pop removes and returns the item on top of the stack.
+// It returns false if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var zero T
+	n := len(s.data)
+	if n == 0 {
+		return zero, false
+	}
+	v := s.data[n-1]
+	s.data[n-1] = zero
+	s.data = s.data[:n-1]
+	return v, true
+}
+
+// Peek returns the item on top of the stack without removing it.
+// It returns false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n := len(s.data)
+	if n == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.data[n-1], true
+}
+
+// Len returns the number of items in the stack.
+func (s *Stack[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// Cap returns the capacity of a bounded stack, or 0 if it is unbounded.
+func (s *Stack[T]) Cap() int {
+	return s.capacity
+}
+
+// Clear removes all items from the stack.
+func (s *Stack[T]) Clear() {
+	s.mu.Lock()
+	clear(s.data)
+	s.data = s.data[:0]
+	s.mu.Unlock()
+}
+
+// ToSlice returns a copy of the items, from bottom to top.
+func (s *Stack[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cpy := make([]T, len(s.data))
+	copy(cpy, s.data)
+	return cpy
+}
@@ -0,0 +1,52 @@
+package stacks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	s := New(1, 2)
+	s.Push(3)
+	if v, ok := s.Peek(); !ok || v != 3 {
+		t.Fatalf("expected 3 on top, got %d (ok=%v)", v, ok)
+	}
+	if got := s.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("expected bottom-to-top order, got %v", got)
+	}
+	for want := 3; want >= 1; want-- {
+		if v, ok := s.Pop(); !ok || v != want {
+			t.Fatalf("expected %d, got %d (ok=%v)", want, v, ok)
+		}
+	}
+	if _, ok := s.Pop(); ok || s.Len() != 0 {
+		t.Fatalf("expected stack to be empty")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Fatalf("expected Peek on an empty stack to fail")
+	}
+}
+
+func TestBoundedStack(t *testing.T) {
+	s := NewBounded[string](2)
+	if !s.Push("a") || !s.Push("b") {
+		t.Fatalf("expected pushes within capacity to succeed")
+	}
+	if s.Push("c") {
+		t.Fatalf("expected push onto a full stack to fail")
+	}
+	if v, _ := s.Pop(); v != "b" || !s.Push("c") {
+		t.Fatalf("expected room after Pop")
+	}
+	s.Clear()
+	if s.Len() != 0 || s.Cap() != 2 {
+		t.Fatalf("expected an empty stack keeping its capacity")
+	}
+
+	for _, capacity := range []int{0, -1} {
+		s := NewBounded[int](capacity)
+		if s.Cap() != 1 || !s.Push(1) || s.Push(2) {
+			t.Fatalf("expected capacity %d to be treated as 1", capacity)
+		}
+	}
+}
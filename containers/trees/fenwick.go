@@ -0,0 +1,74 @@
+package trees
+
+import (
+	"sync"
+
+	"github.com/go-kratos/kit/constraints"
+)
+
+// FenwickTree is a thread-safe binary indexed tree over a fixed number of buckets.
+// It supports point updates and prefix or range sums in O(log n).
+type FenwickTree[N constraints.Number] struct {
+	mu   sync.RWMutex
+	tree []N
+}
+
+// NewFenwick creates a FenwickTree of n zero-valued buckets.
+func NewFenwick[N constraints.Number](n int) *FenwickTree[N] {
+	return &FenwickTree[N]{tree: make([]N, max(n, 0)+1)}
+}
+
+// Len returns the number of buckets.
+func (t *FenwickTree[N]) Len() int {
+	return len(t.tree) - 1
+}
+
+// Add adds delta to bucket i. It panics if i is out of range.
+func (t *FenwickTree[N]) Add(i int, delta N) {
+	if i < 0 || i >= t.Len() {
+		panic("trees: FenwickTree index out of range")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i++; i < len(t.tree); i += i & -i {
+		t.tree[i] += delta
+	}
+}
+
+// PrefixSum returns the sum of buckets [0, i). i is clamped to [0, Len()].
+func (t *FenwickTree[N]) PrefixSum(i int) N {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.prefixSum(min(max(i, 0), t.Len()))
+}
+
+func (t *FenwickTree[N]) prefixSum(i int) N {
+	var sum N
+	for ; i > 0; i -= i & -i {
+		sum += t.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of buckets [from, to).
+func (t *FenwickTree[N]) RangeSum(from, to int) N {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	from, to = min(max(from, 0), t.Len()), min(max(to, 0), t.Len())
+	if from >= to {
+		return 0
+	}
+	return t.prefixSum(to) - t.prefixSum(from)
+}
+
+// Get returns the value of bucket i.
+func (t *FenwickTree[N]) Get(i int) N {
+	return t.RangeSum(i, i+1)
+}
+
+// Clear resets every bucket to zero.
+func (t *FenwickTree[N]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	clear(t.tree)
+}
@@ -0,0 +1,49 @@
+package trees
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFenwickTree(t *testing.T) {
+	f := NewFenwick[int](8)
+	for i := 0; i < 8; i++ {
+		f.Add(i, i+1)
+	}
+	if got := f.PrefixSum(4); got != 1+2+3+4 {
+		t.Fatalf("unexpected prefix sum: %d", got)
+	}
+	if got := f.RangeSum(2, 5); got != 3+4+5 {
+		t.Fatalf("unexpected range sum: %d", got)
+	}
+	f.Add(3, -4)
+	if got := f.Get(3); got != 0 {
+		t.Fatalf("expected bucket 3 to be 0, got %d", got)
+	}
+	if got := f.PrefixSum(100); got != 36-4 {
+		t.Fatalf("expected prefix sum to clamp, got %d", got)
+	}
+}
+
+func TestSegmentTree(t *testing.T) {
+	values := []int{5, 3, 8, 1, 9, 2}
+	s := NewSegmentTree(values, func(a, b int) int { return min(a, b) }, math.MaxInt)
+	if got := s.Query(0, 6); got != 1 {
+		t.Fatalf("expected min 1, got %d", got)
+	}
+	if got := s.Query(4, 6); got != 2 {
+		t.Fatalf("expected min 2, got %d", got)
+	}
+	s.Set(3, 7)
+	if got := s.Query(1, 5); got != 3 {
+		t.Fatalf("expected min 3 after update, got %d", got)
+	}
+	if got := s.Query(3, 3); got != math.MaxInt {
+		t.Fatalf("expected identity for empty range, got %d", got)
+	}
+
+	concat := NewSegmentTree([]string{"a", "b", "c", "d", "e"}, func(a, b string) string { return a + b }, "")
+	if got := concat.Query(1, 4); got != "bcd" {
+		t.Fatalf("expected order to be preserved, got %q", got)
+	}
+}
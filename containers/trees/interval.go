@@ -0,0 +1,236 @@
+package trees
+
+import (
+	"cmp"
+	"sync"
+)
+
+// Interval is a half-open range [Start, End) with an associated value.
+type Interval[K cmp.Ordered, V any] struct {
+	Start K
+	End   K
+	Value V
+}
+
+func (iv Interval[K, V]) compare(start, end K) int {
+	if c := cmp.Compare(iv.Start, start); c != 0 {
+		return c
+	}
+	return cmp.Compare(iv.End, end)
+}
+
+type intervalNode[K cmp.Ordered, V any] struct {
+	iv          Interval[K, V]
+	maxEnd      K
+	height      int
+	left, right *intervalNode[K, V]
+}
+
+func (n *intervalNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *intervalNode[K, V]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.maxEnd = n.iv.End
+	if n.left != nil {
+		n.maxEnd = max(n.maxEnd, n.left.maxEnd)
+	}
+	if n.right != nil {
+		n.maxEnd = max(n.maxEnd, n.right.maxEnd)
+	}
+}
+
+func (n *intervalNode[K, V]) rotateLeft() *intervalNode[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	n.update()
+	x.update()
+	return x
+}
+
+func (n *intervalNode[K, V]) rotateRight() *intervalNode[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	n.update()
+	x.update()
+	return x
+}
+
+// rebalance restores the AVL invariant at n after one of its subtrees changed.
+func (n *intervalNode[K, V]) rebalance() *intervalNode[K, V] {
+	n.update()
+	switch balance := n.left.getHeight() - n.right.getHeight(); {
+	case balance > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// IntervalTree is a thread-safe set of half-open intervals that answers stabbing
+// and overlap queries in O(log n + k). It is an AVL tree ordered by start, with
+// every node tracking the largest end in its subtree.
+type IntervalTree[K cmp.Ordered, V any] struct {
+	mu     sync.RWMutex
+	root   *intervalNode[K, V]
+	length int
+}
+
+// NewIntervalTree creates an empty IntervalTree.
+func NewIntervalTree[K cmp.Ordered, V any]() *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{}
+}
+
+// Insert adds the interval [start, end) with value, replacing the value of an
+// identical interval. Empty intervals, with end <= start, are ignored.
+func (t *IntervalTree[K, V]) Insert(start, end K, value V) {
+	if end <= start {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.root = t.insert(t.root, Interval[K, V]{Start: start, End: end, Value: value})
+}
+
+func (t *IntervalTree[K, V]) insert(n *intervalNode[K, V], iv Interval[K, V]) *intervalNode[K, V] {
+	if n == nil {
+		t.length++
+		return &intervalNode[K, V]{iv: iv, maxEnd: iv.End, height: 1}
+	}
+	switch c := iv.compare(n.iv.Start, n.iv.End); {
+	case c < 0:
+		n.left = t.insert(n.left, iv)
+	case c > 0:
+		n.right = t.insert(n.right, iv)
+	default:
+		n.iv.Value = iv.Value
+		return n
+	}
+	return n.rebalance()
+}
+
+// Delete removes the interval [start, end) and reports whether it was present.
+func (t *IntervalTree[K, V]) Delete(start, end K) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var deleted bool
+	t.root = t.delete(t.root, start, end, &deleted)
+	if deleted {
+		t.length--
+	}
+	return deleted
+}
+
+func (t *IntervalTree[K, V]) delete(n *intervalNode[K, V], start, end K, deleted *bool) *intervalNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := n.iv.compare(start, end); {
+	case c > 0:
+		n.left = t.delete(n.left, start, end, deleted)
+	case c < 0:
+		n.right = t.delete(n.right, start, end, deleted)
+	default:
+		*deleted = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.iv = successor.iv
+		var ignored bool
+		n.right = t.delete(n.right, successor.iv.Start, successor.iv.End, &ignored)
+	}
+	return n.rebalance()
+}
+
+// Stab returns every interval containing point, ordered by start.
+func (t *IntervalTree[K, V]) Stab(point K) []Interval[K, V] {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var out []Interval[K, V]
+	var walk func(n *intervalNode[K, V])
+	walk = func(n *intervalNode[K, V]) {
+		if n == nil || n.maxEnd <= point {
+			return
+		}
+		walk(n.left)
+		if n.iv.Start > point {
+			return
+		}
+		if point < n.iv.End {
+			out = append(out, n.iv)
+		}
+		walk(n.right)
+	}
+	walk(t.root)
+	return out
+}
+
+// Overlaps returns every interval overlapping [start, end), ordered by start.
+func (t *IntervalTree[K, V]) Overlaps(start, end K) []Interval[K, V] {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var out []Interval[K, V]
+	var walk func(n *intervalNode[K, V])
+	walk = func(n *intervalNode[K, V]) {
+		if n == nil || n.maxEnd <= start {
+			return
+		}
+		walk(n.left)
+		if n.iv.Start >= end {
+			return
+		}
+		if start < n.iv.End {
+			out = append(out, n.iv)
+		}
+		walk(n.right)
+	}
+	walk(t.root)
+	return out
+}
+
+// Len returns the number of intervals in the tree.
+func (t *IntervalTree[K, V]) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.length
+}
+
+// Clear removes all intervals from the tree.
+func (t *IntervalTree[K, V]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.root = nil
+	t.length = 0
+}
+
+// Range calls f for every interval ordered by start, then end.
+// If f returns false, iteration stops. f must not modify the tree.
+func (t *IntervalTree[K, V]) Range(f func(iv Interval[K, V]) bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var walk func(n *intervalNode[K, V]) bool
+	walk = func(n *intervalNode[K, V]) bool {
+		return n == nil || (walk(n.left) && f(n.iv) && walk(n.right))
+	}
+	walk(t.root)
+}
@@ -0,0 +1,75 @@
+package trees
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func names(ivs []Interval[int, string]) []string {
+	out := make([]string, 0, len(ivs))
+	for _, iv := range ivs {
+		out = append(out, iv.Value)
+	}
+	return out
+}
+
+func TestIntervalTree(t *testing.T) {
+	tree := NewIntervalTree[int, string]()
+	tree.Insert(0, 10, "a")
+	tree.Insert(5, 15, "b")
+	tree.Insert(20, 30, "c")
+	tree.Insert(10, 20, "d")
+	tree.Insert(3, 3, "empty")
+
+	if got := names(tree.Stab(10)); !slices.Equal(got, []string{"b", "d"}) {
+		t.Fatalf("unexpected stab result: %v", got)
+	}
+	if got := names(tree.Stab(30)); len(got) != 0 {
+		t.Fatalf("expected end to be exclusive, got %v", got)
+	}
+	if got := names(tree.Overlaps(12, 21)); !slices.Equal(got, []string{"b", "d", "c"}) {
+		t.Fatalf("unexpected overlap result: %v", got)
+	}
+	if tree.Len() != 4 {
+		t.Fatalf("expected empty interval to be ignored, got %d intervals", tree.Len())
+	}
+	if !tree.Delete(5, 15) || tree.Delete(5, 15) {
+		t.Fatalf("unexpected Delete results")
+	}
+	if got := names(tree.Stab(10)); !slices.Equal(got, []string{"d"}) {
+		t.Fatalf("unexpected stab result after delete: %v", got)
+	}
+}
+
+func TestIntervalTreeMatchesBruteForce(t *testing.T) {
+	tree := NewIntervalTree[int, int]()
+	r := rand.New(rand.NewPCG(1, 2))
+	type span struct{ start, end int }
+	live := make(map[span]bool)
+	for i := 0; i < 2000; i++ {
+		s := span{r.IntN(1000), 0}
+		s.end = s.start + 1 + r.IntN(50)
+		if r.IntN(3) == 0 {
+			tree.Delete(s.start, s.end)
+			delete(live, s)
+		} else {
+			tree.Insert(s.start, s.end, i)
+			live[s] = true
+		}
+	}
+	if tree.Len() != len(live) {
+		t.Fatalf("expected %d intervals, got %d", len(live), tree.Len())
+	}
+	for p := 0; p < 1100; p += 7 {
+		want := 0
+		for s := range live {
+			if s.start <= p && p < s.end {
+				want++
+			}
+		}
+		if got := len(tree.Stab(p)); got != want {
+			t.Fatalf("stab %d: expected %d intervals, got %d", p, want, got)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package trees
+
+import "sync"
+
+// SegmentTree is a thread-safe tree over a fixed array that answers range queries
+// for any associative combine function, such as sum, min or max, in O(log n).
+type SegmentTree[T any] struct {
+	mu       sync.RWMutex
+	n        int
+	tree     []T
+	combine  func(a, b T) T
+	identity T
+}
+
+// NewSegmentTree creates a SegmentTree over values. combine must be associative
+// and identity must satisfy combine(identity, x) == x for every x.
+func NewSegmentTree[T any](values []T, combine func(a, b T) T, identity T) *SegmentTree[T] {
+	n := len(values)
+	t := &SegmentTree[T]{n: n, tree: make([]T, 2*n), combine: combine, identity: identity}
+	copy(t.tree[n:], values)
+	for i := n - 1; i > 0; i-- {
+		t.tree[i] = combine(t.tree[2*i], t.tree[2*i+1])
+	}
+	return t
+}
+
+// Len returns the number of elements.
+func (t *SegmentTree[T]) Len() int {
+	return t.n
+}
+
+// Set replaces element i with value. It panics if i is out of range.
+func (t *SegmentTree[T]) Set(i int, value T) {
+	if i < 0 || i >= t.n {
+		panic("trees: SegmentTree index out of range")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	i += t.n
+	t.tree[i] = value
+	for i /= 2; i > 0; i /= 2 {
+		t.tree[i] = t.combine(t.tree[2*i], t.tree[2*i+1])
+	}
+}
+
+// Get returns element i. It panics if i is out of range.
+func (t *SegmentTree[T]) Get(i int) T {
+	if i < 0 || i >= t.n {
+		panic("trees: SegmentTree index out of range")
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.tree[i+t.n]
+}
+
+// Query combines the elements [from, to) in order, returning identity for an empty range.
+func (t *SegmentTree[T]) Query(from, to int) T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	left, right := t.identity, t.identity
+	for l, r := max(from, 0)+t.n, min(to, t.n)+t.n; l < r; l, r = l/2, r/2 {
+		if l&1 == 1 {
+			left = t.combine(left, t.tree[l])
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			right = t.combine(t.tree[r], right)
+		}
+	}
+	return t.combine(left, right)
+}
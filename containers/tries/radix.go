@@ -0,0 +1,230 @@
+package tries
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+type radixNode[V any] struct {
+	// prefix is the label of the edge leading to this node.
+	prefix string
+	// children are kept sorted by the first byte of their prefix.
+	children []*radixNode[V]
+	value    V
+	hasValue bool
+}
+
+func (n *radixNode[V]) child(b byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, b, func(c *radixNode[V], b byte) int {
+		return int(c.prefix[0]) - int(b)
+	})
+}
+
+func (n *radixNode[V]) addChild(c *radixNode[V]) {
+	i, _ := n.child(c.prefix[0])
+	n.children = slices.Insert(n.children, i, c)
+}
+
+// mergeChild folds a valueless node's only child into it.
+func (n *radixNode[V]) mergeChild() {
+	c := n.children[0]
+	n.prefix += c.prefix
+	n.children = c.children
+	n.value, n.hasValue = c.value, c.hasValue
+}
+
+// RadixTree is a thread-safe compressed prefix tree mapping string keys to values.
+// Chains of single-child nodes are collapsed into one edge, so it uses far less memory
+// than a Trie for long keys, and supports longest-prefix matching for route lookups.
+type RadixTree[V any] struct {
+	mu   sync.RWMutex
+	root radixNode[V]
+	size int
+}
+
+// NewRadixTree creates an empty RadixTree.
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{}
+}
+
+func commonPrefixLen(a, b string) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// Insert sets the value for key, replacing any existing value.
+func (t *RadixTree[V]) Insert(key string, value V) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n, search := &t.root, key
+	for {
+		if search == "" {
+			if !n.hasValue {
+				t.size++
+			}
+			n.value, n.hasValue = value, true
+			return
+		}
+		i, ok := n.child(search[0])
+		if !ok {
+			n.addChild(&radixNode[V]{prefix: search, value: value, hasValue: true})
+			t.size++
+			return
+		}
+		c := n.children[i]
+		common := commonPrefixLen(search, c.prefix)
+		if common == len(c.prefix) {
+			n, search = c, search[common:]
+			continue
+		}
+		// Split the edge at the point where key diverges from it.
+		mid := &radixNode[V]{prefix: c.prefix[:common]}
+		c.prefix = c.prefix[common:]
+		mid.children = []*radixNode[V]{c}
+		n.children[i] = mid
+		if search = search[common:]; search == "" {
+			mid.value, mid.hasValue = value, true
+		} else {
+			mid.addChild(&radixNode[V]{prefix: search, value: value, hasValue: true})
+		}
+		t.size++
+		return
+	}
+}
+
+// Get retrieves the value for key.
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	n, search := &t.root, key
+	for search != "" {
+		i, ok := n.child(search[0])
+		if !ok || !strings.HasPrefix(search, n.children[i].prefix) {
+			var zero V
+			return zero, false
+		}
+		n = n.children[i]
+		search = search[len(n.prefix):]
+	}
+	return n.value, n.hasValue
+}
+
+// LongestPrefix returns the longest stored key that is a prefix of key, and its value.
+// It returns false if no stored key is a prefix of key.
+func (t *RadixTree[V]) LongestPrefix(key string) (string, V, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var (
+		match    string
+		value    V
+		found    bool
+		consumed int
+	)
+	n := &t.root
+	for {
+		if n.hasValue {
+			match, value, found = key[:consumed], n.value, true
+		}
+		search := key[consumed:]
+		if search == "" {
+			break
+		}
+		i, ok := n.child(search[0])
+		if !ok || !strings.HasPrefix(search, n.children[i].prefix) {
+			break
+		}
+		n = n.children[i]
+		consumed += len(n.prefix)
+	}
+	return match, value, found
+}
+
+// Delete removes the value for key and reports whether it was present.
+func (t *RadixTree[V]) Delete(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var (
+		parent *radixNode[V]
+		index  int
+	)
+	n, search := &t.root, key
+	for search != "" {
+		i, ok := n.child(search[0])
+		if !ok || !strings.HasPrefix(search, n.children[i].prefix) {
+			return false
+		}
+		parent, index, n = n, i, n.children[i]
+		search = search[len(n.prefix):]
+	}
+	if !n.hasValue {
+		return false
+	}
+	var zero V
+	n.value, n.hasValue = zero, false
+	t.size--
+	if n == &t.root {
+		return true
+	}
+	switch len(n.children) {
+	case 0:
+		parent.children = slices.Delete(parent.children, index, index+1)
+		if parent != &t.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return true
+}
+
+// WalkPrefix calls f in lexical order for every key starting with prefix.
+// If f returns false, the walk stops. f must not modify the tree.
+func (t *RadixTree[V]) WalkPrefix(prefix string, f func(key string, value V) bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	n, search, key := &t.root, prefix, ""
+	for search != "" {
+		i, ok := n.child(search[0])
+		if !ok {
+			return
+		}
+		c := n.children[i]
+		switch {
+		case strings.HasPrefix(search, c.prefix):
+			key += c.prefix
+			search = search[len(c.prefix):]
+			n = c
+		case strings.HasPrefix(c.prefix, search):
+			walkRadix(c, key+c.prefix, f)
+			return
+		default:
+			return
+		}
+	}
+	walkRadix(n, key, f)
+}
+
+func walkRadix[V any](n *radixNode[V], key string, f func(key string, value V) bool) bool {
+	if n.hasValue && !f(key, n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !walkRadix(c, key+c.prefix, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of keys in the tree.
+func (t *RadixTree[V]) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.size
+}
@@ -0,0 +1,138 @@
+package tries
+
+import (
+	"slices"
+	"sync"
+)
+
+type trieNode[V any] struct {
+	children map[byte]*trieNode[V]
+	value    V
+	hasValue bool
+}
+
+// Trie is a thread-safe prefix tree mapping string keys to values.
+type Trie[V any] struct {
+	mu   sync.RWMutex
+	root trieNode[V]
+	size int
+}
+
+// New creates an empty Trie.
+func New[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// Insert sets the value for key, replacing any existing value.
+func (t *Trie[V]) Insert(key string, value V) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := &t.root
+	for i := 0; i < len(key); i++ {
+		if n.children == nil {
+			n.children = make(map[byte]*trieNode[V])
+		}
+		child, ok := n.children[key[i]]
+		if !ok {
+			child = &trieNode[V]{}
+			n.children[key[i]] = child
+		}
+		n = child
+	}
+	if !n.hasValue {
+		t.size++
+	}
+	n.value, n.hasValue = value, true
+}
+
+// find returns the node reached by walking key, or nil.
+// The caller must hold the lock.
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := &t.root
+	for i := 0; i < len(key) && n != nil; i++ {
+		n = n.children[key[i]]
+	}
+	return n
+}
+
+// Get retrieves the value for key.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if n := t.find(key); n != nil && n.hasValue {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes the value for key and reports whether it was present.
+// Nodes left without values or children are pruned.
+func (t *Trie[V]) Delete(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	path := make([]*trieNode[V], 0, len(key)+1)
+	n := &t.root
+	path = append(path, n)
+	for i := 0; i < len(key); i++ {
+		if n = n.children[key[i]]; n == nil {
+			return false
+		}
+		path = append(path, n)
+	}
+	if !n.hasValue {
+		return false
+	}
+	var zero V
+	n.value, n.hasValue = zero, false
+	t.size--
+	for i := len(path) - 1; i > 0; i-- {
+		if path[i].hasValue || len(path[i].children) > 0 {
+			break
+		}
+		delete(path[i-1].children, key[i-1])
+	}
+	return true
+}
+
+// HasPrefix reports whether any key starts with prefix.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	n := t.find(prefix)
+	return n != nil && (n.hasValue || len(n.children) > 0)
+}
+
+// WalkPrefix calls f in lexical order for every key starting with prefix.
+// If f returns false, the walk stops. f must not modify the trie.
+func (t *Trie[V]) WalkPrefix(prefix string, f func(key string, value V) bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if n := t.find(prefix); n != nil {
+		walkTrie(n, []byte(prefix), f)
+	}
+}
+
+func walkTrie[V any](n *trieNode[V], key []byte, f func(key string, value V) bool) bool {
+	if n.hasValue && !f(string(key), n.value) {
+		return false
+	}
+	labels := make([]byte, 0, len(n.children))
+	for b := range n.children {
+		labels = append(labels, b)
+	}
+	slices.Sort(labels)
+	for _, b := range labels {
+		if !walkTrie(n.children[b], append(key, b), f) {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of keys in the trie.
+func (t *Trie[V]) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.size
+}
@@ -0,0 +1,77 @@
+package tries
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTrieWalkPrefix(t *testing.T) {
+	tr := New[int]()
+	for i, k := range []string{"order.created", "order.paid", "orders", "user.created"} {
+		tr.Insert(k, i)
+	}
+
+	var keys []string
+	tr.WalkPrefix("order", func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if want := []string{"order.created", "order.paid", "orders"}; !slices.Equal(keys, want) {
+		t.Fatalf("expected %v, got %v", want, keys)
+	}
+
+	if !tr.Delete("order.paid") || tr.Delete("order.paid") {
+		t.Fatalf("expected exactly one successful delete")
+	}
+	if tr.HasPrefix("order.p") {
+		t.Fatalf("expected pruned prefix to be gone")
+	}
+	if v, ok := tr.Get("orders"); !ok || v != 2 {
+		t.Fatalf("expected 2, got %d (ok=%v)", v, ok)
+	}
+	if tr.Len() != 3 {
+		t.Fatalf("expected 3 keys, got %d", tr.Len())
+	}
+}
+
+func TestRadixTreeLongestPrefix(t *testing.T) {
+	tr := NewRadixTree[string]()
+	tr.Insert("/api", "api")
+	tr.Insert("/api/v1/users", "users")
+	tr.Insert("/api/v1", "v1")
+	tr.Insert("/apidocs", "docs")
+
+	cases := map[string]string{
+		"/api/v1/users/42": "/api/v1/users",
+		"/api/v1/orders":   "/api/v1",
+		"/api/v2":          "/api",
+		"/apidocs/index":   "/apidocs",
+	}
+	for key, want := range cases {
+		if got, _, ok := tr.LongestPrefix(key); !ok || got != want {
+			t.Fatalf("LongestPrefix(%q): expected %q, got %q (ok=%v)", key, want, got, ok)
+		}
+	}
+	if _, _, ok := tr.LongestPrefix("/other"); ok {
+		t.Fatalf("expected no match for /other")
+	}
+
+	if !tr.Delete("/api/v1") {
+		t.Fatalf("expected /api/v1 to be deleted")
+	}
+	if got, _, _ := tr.LongestPrefix("/api/v1/orders"); got != "/api" {
+		t.Fatalf("expected /api after delete, got %q", got)
+	}
+	if v, ok := tr.Get("/api/v1/users"); !ok || v != "users" {
+		t.Fatalf("expected users, got %q (ok=%v)", v, ok)
+	}
+
+	var keys []string
+	tr.WalkPrefix("/ap", func(key, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if want := []string{"/api", "/api/v1/users", "/apidocs"}; !slices.Equal(keys, want) {
+		t.Fatalf("expected %v, got %v", want, keys)
+	}
+}
@@ -0,0 +1,78 @@
+package either
+
+import "fmt"
+
+// Either holds exactly one of a left value of type L or a right value of type R.
+// The zero value holds the zero L.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left returns an Either holding the left value l.
+func Left[L, R any](l L) Either[L, R] {
+	return Either[L, R]{left: l}
+}
+
+// Right returns an Either holding the right value r.
+func Right[L, R any](r R) Either[L, R] {
+	return Either[L, R]{right: r, isRight: true}
+}
+
+// IsLeft reports whether the Either holds a left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight reports whether the Either holds a right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// LeftValue returns the left value and whether the Either holds one.
+func (e Either[L, R]) LeftValue() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// RightValue returns the right value and whether the Either holds one.
+func (e Either[L, R]) RightValue() (R, bool) {
+	return e.right, e.isRight
+}
+
+// Swap returns an Either with the left and right sides exchanged.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+// String returns Left(value) or Right(value).
+func (e Either[L, R]) String() string {
+	if e.isRight {
+		return fmt.Sprintf("Right(%v)", e.right)
+	}
+	return fmt.Sprintf("Left(%v)", e.left)
+}
+
+// Fold returns onLeft applied to the left value or onRight applied to the right value.
+func Fold[L, R, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// MapLeft transforms the left value with f, leaving a right value unchanged.
+func MapLeft[L, R, T any](e Either[L, R], f func(L) T) Either[T, R] {
+	if e.isRight {
+		return Right[T](e.right)
+	}
+	return Left[T, R](f(e.left))
+}
+
+// MapRight transforms the right value with f, leaving a left value unchanged.
+func MapRight[L, R, T any](e Either[L, R], f func(R) T) Either[L, T] {
+	if e.isRight {
+		return Right[L](f(e.right))
+	}
+	return Left[L, T](e.left)
+}
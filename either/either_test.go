@@ -0,0 +1,35 @@
+package either
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestEither(t *testing.T) {
+	describe := func(e Either[int, string]) string {
+		return Fold(e, strconv.Itoa, func(s string) string { return "name:" + s })
+	}
+	items := []Either[int, string]{Left[int, string](42), Right[int]("kratos")}
+	if got := describe(items[0]); got != "42" {
+		t.Fatalf("unexpected fold of left: %s", got)
+	}
+	if got := describe(items[1]); got != "name:kratos" {
+		t.Fatalf("unexpected fold of right: %s", got)
+	}
+
+	swapped := items[1].Swap()
+	if v, ok := swapped.LeftValue(); !ok || v != "kratos" {
+		t.Fatalf("expected swapped right to become left, got %v", swapped)
+	}
+	if _, ok := swapped.RightValue(); ok {
+		t.Fatalf("expected swapped Either to have no right value")
+	}
+
+	mapped := MapRight(items[0], func(s string) int { return len(s) })
+	if v, ok := mapped.LeftValue(); !ok || v != 42 {
+		t.Fatalf("expected MapRight to keep the left value, got %v", mapped)
+	}
+	if s := MapLeft(items[0], strconv.Itoa).String(); s != "Left(42)" {
+		t.Fatalf("unexpected MapLeft result: %s", s)
+	}
+}
@@ -0,0 +1,116 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Optional holds either a value or nothing. The zero value is None.
+type Optional[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Optional holding value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, ok: true}
+}
+
+// None returns an empty Optional.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Of returns Some(value) if ok is true and None otherwise, adapting (T, bool) returns.
+func Of[T any](value T, ok bool) Optional[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// Get returns the value and whether it is present.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// IsSome reports whether the Optional holds a value.
+func (o Optional[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether the Optional is empty.
+func (o Optional[T]) IsNone() bool {
+	return !o.ok
+}
+
+// MustGet returns the value, panicking if the Optional is empty.
+func (o Optional[T]) MustGet() T {
+	if !o.ok {
+		panic("optional: MustGet called on None")
+	}
+	return o.value
+}
+
+// OrElse returns the value if present, or fallback otherwise.
+func (o Optional[T]) OrElse(fallback T) T {
+	if o.ok {
+		return o.value
+	}
+	return fallback
+}
+
+// OrElseFunc returns the value if present, or the result of fallback otherwise.
+func (o Optional[T]) OrElseFunc(fallback func() T) T {
+	if o.ok {
+		return o.value
+	}
+	return fallback()
+}
+
+// String returns Some(value) or None.
+func (o Optional[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// MarshalJSON encodes the value, or null if the Optional is empty.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// Map returns Some(f(value)) if o holds a value, and None otherwise.
+func Map[T, U any](o Optional[T], f func(T) U) Optional[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(f(o.value))
+}
+
+// FlatMap returns f(value) if o holds a value, and None otherwise.
+func FlatMap[T, U any](o Optional[T], f func(T) Optional[U]) Optional[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return f(o.value)
+}
@@ -0,0 +1,46 @@
+package optional
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+func TestOptional(t *testing.T) {
+	some := Some(21)
+	none := None[int]()
+	if v, ok := some.Get(); !ok || v != 21 {
+		t.Fatalf("unexpected Get: %d, %v", v, ok)
+	}
+	if none.OrElse(7) != 7 || some.OrElse(7) != 21 {
+		t.Fatalf("unexpected OrElse results")
+	}
+	doubled := Map(some, func(v int) int { return v * 2 })
+	if doubled.MustGet() != 42 {
+		t.Fatalf("expected Map to double the value, got %v", doubled)
+	}
+	if s := Map(none, strconv.Itoa); s.IsSome() {
+		t.Fatalf("expected Map over None to be None")
+	}
+	if Of(0, false).IsSome() || !Of(0, true).IsSome() {
+		t.Fatalf("unexpected Of results")
+	}
+}
+
+func TestOptionalJSON(t *testing.T) {
+	type payload struct {
+		Name Optional[string] `json:"name"`
+		Age  Optional[int]    `json:"age"`
+	}
+	data, err := json.Marshal(payload{Name: Some("kratos")})
+	if err != nil || string(data) != `{"name":"kratos","age":null}` {
+		t.Fatalf("unexpected JSON: %s, %v", data, err)
+	}
+	var p payload
+	if err := json.Unmarshal([]byte(`{"name":null,"age":3}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Name.IsSome() || p.Age.OrElse(0) != 3 {
+		t.Fatalf("unexpected decoded payload: %v, %v", p.Name, p.Age)
+	}
+}
@@ -0,0 +1,151 @@
+package pubsub
+
+import "sync"
+
+// Policy decides what Publish does when a subscriber's buffer is full.
+type Policy int
+
+const (
+	// Block waits until the subscriber has room or unsubscribes.
+	Block Policy = iota
+	// DropOldest discards the oldest buffered value to make room for the new one.
+	DropOldest
+	// DropNewest discards the new value for that subscriber.
+	DropNewest
+)
+
+type options struct {
+	buffer int
+	policy Policy
+}
+
+// Option configures a Topic.
+type Option func(*options)
+
+// WithBuffer sets the number of values buffered per subscriber. Default is 16.
+// A buffer less than 1 is treated as 1.
+func WithBuffer(n int) Option {
+	return func(o *options) {
+		o.buffer = max(n, 1)
+	}
+}
+
+// WithPolicy sets how slow subscribers are handled. Default is Block.
+func WithPolicy(p Policy) Option {
+	return func(o *options) {
+		o.policy = p
+	}
+}
+
+type subscriber[T any] struct {
+	ch   chan T
+	done chan struct{}
+}
+
+// Topic broadcasts published values to every current subscriber.
+// It is safe for concurrent use.
+type Topic[T any] struct {
+	mu     sync.RWMutex
+	subs   map[*subscriber[T]]struct{}
+	opts   options
+	closed bool
+	done   chan struct{}
+	once   sync.Once
+}
+
+// NewTopic creates a Topic with no subscribers.
+func NewTopic[T any](opts ...Option) *Topic[T] {
+	o := options{buffer: 16, policy: Block}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Topic[T]{subs: make(map[*subscriber[T]]struct{}), opts: o, done: make(chan struct{})}
+}
+
+// Subscribe returns a channel receiving every value published from now on and a
+// function that unsubscribes and closes the channel. The channel is also closed
+// when the Topic is closed.
+func (t *Topic[T]) Subscribe() (<-chan T, func()) {
+	s := &subscriber[T]{ch: make(chan T, t.opts.buffer), done: make(chan struct{})}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		close(s.ch)
+		return s.ch, func() {}
+	}
+	t.subs[s] = struct{}{}
+	var once sync.Once
+	return s.ch, func() {
+		once.Do(func() {
+			// Wake a publisher blocked on this subscriber before taking the lock.
+			close(s.done)
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if _, ok := t.subs[s]; ok {
+				delete(t.subs, s)
+				close(s.ch)
+			}
+		})
+	}
+}
+
+// Publish sends value to every subscriber according to the Topic's policy.
+// It does nothing once the Topic is closed.
+func (t *Topic[T]) Publish(value T) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for s := range t.subs {
+		t.send(s, value)
+	}
+}
+
+func (t *Topic[T]) send(s *subscriber[T], value T) {
+	switch t.opts.policy {
+	case DropNewest:
+		select {
+		case s.ch <- value:
+		default:
+		}
+	case DropOldest:
+		for {
+			select {
+			case s.ch <- value:
+				return
+			default:
+			}
+			select {
+			case <-s.ch:
+			default:
+			}
+		}
+	default:
+		select {
+		case s.ch <- value:
+		case <-s.done:
+		case <-t.done:
+		}
+	}
+}
+
+// Len returns the number of subscribers.
+func (t *Topic[T]) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.subs)
+}
+
+// Close unsubscribes everyone and closes their channels.
+func (t *Topic[T]) Close() {
+	// Wake publishers blocked on slow subscribers before taking the lock.
+	t.once.Do(func() { close(t.done) })
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+	t.closed = true
+	for s := range t.subs {
+		close(s.ch)
+	}
+	clear(t.subs)
+}
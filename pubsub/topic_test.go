@@ -0,0 +1,84 @@
+package pubsub
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func drain[T any](ch <-chan T) []T {
+	var values []T
+	for v := range ch {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestTopic(t *testing.T) {
+	topic := NewTopic[int]()
+	a, _ := topic.Subscribe()
+	b, cancel := topic.Subscribe()
+	topic.Publish(1)
+	cancel()
+	cancel()
+	topic.Publish(2)
+	if topic.Len() != 1 {
+		t.Fatalf("expected 1 subscriber, got %d", topic.Len())
+	}
+	topic.Close()
+	if got := drain(a); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("unexpected values for a: %v", got)
+	}
+	if got := drain(b); !slices.Equal(got, []int{1}) {
+		t.Fatalf("unexpected values for b: %v", got)
+	}
+	if c, _ := topic.Subscribe(); len(drain(c)) != 0 {
+		t.Fatal("expected a closed channel after Close")
+	}
+}
+
+func TestTopicPolicies(t *testing.T) {
+	for _, tc := range []struct {
+		policy Policy
+		want   []int
+	}{
+		{DropOldest, []int{3, 4}},
+		{DropNewest, []int{1, 2}},
+	} {
+		topic := NewTopic[int](WithBuffer(2), WithPolicy(tc.policy))
+		ch, _ := topic.Subscribe()
+		for i := 1; i <= 4; i++ {
+			topic.Publish(i)
+		}
+		topic.Close()
+		if got := drain(ch); !slices.Equal(got, tc.want) {
+			t.Fatalf("policy %d: expected %v, got %v", tc.policy, tc.want, got)
+		}
+	}
+}
+
+func TestTopicBlockUnsubscribe(t *testing.T) {
+	topic := NewTopic[int](WithBuffer(1))
+	_, cancel := topic.Subscribe()
+	topic.Publish(1)
+	published := make(chan struct{})
+	go func() {
+		topic.Publish(2)
+		close(published)
+	}()
+	select {
+	case <-published:
+		t.Fatal("expected Publish to block on a full subscriber")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancel()
+	<-published
+
+	topic.Subscribe()
+	topic.Publish(1)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		topic.Close()
+	}()
+	topic.Publish(2)
+}
@@ -0,0 +1,89 @@
+package result
+
+import "fmt"
+
+// Result holds either a value or an error, so a (T, error) pair can travel
+// through channels and containers as a single typed value.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err returns a failed Result holding err.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Of wraps the (T, error) returned by a function call.
+func Of[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// IsOk reports whether the Result is successful.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Unwrap returns the value and error of the Result.
+func (r Result[T]) Unwrap() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns the error of the Result, or nil if it is successful.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Must returns the value, panicking if the Result holds an error.
+func (r Result[T]) Must() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("result: Must called on error: %v", r.err))
+	}
+	return r.value
+}
+
+// OrElse returns the value if the Result is successful, or fallback otherwise.
+func (r Result[T]) OrElse(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.value
+}
+
+// String returns Ok(value) or Err(error).
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// Map returns Ok(f(value)) if r is successful, and r's error otherwise.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.value))
+}
+
+// AndThen returns f(value) if r is successful, and r's error otherwise.
+// It chains steps that can each fail.
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return f(r.value)
+}
@@ -0,0 +1,29 @@
+package result
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	parse := func(s string) Result[int] { return Of(strconv.Atoi(s)) }
+
+	r := AndThen(parse("20"), func(v int) Result[int] { return Ok(v + 1) })
+	doubled := Map(r, func(v int) int { return v * 2 })
+	if v, err := doubled.Unwrap(); err != nil || v != 42 {
+		t.Fatalf("unexpected result: %v", doubled)
+	}
+
+	failed := AndThen(parse("x"), func(v int) Result[int] {
+		t.Fatalf("AndThen must not run after an error")
+		return Ok(v)
+	})
+	var numErr *strconv.NumError
+	if !failed.IsErr() || !errors.As(failed.Err(), &numErr) {
+		t.Fatalf("expected a parse error, got %v", failed)
+	}
+	if failed.OrElse(-1) != -1 {
+		t.Fatalf("expected OrElse to return the fallback")
+	}
+}
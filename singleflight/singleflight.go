@@ -0,0 +1,156 @@
+package singleflight
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+// errGoexit is the error seen by callers sharing a call whose function called runtime.Goexit.
+var errGoexit = errors.New("singleflight: function called runtime.Goexit")
+
+// panicError carries a panic from fn, with the stack where it happened, to the callers.
+type panicError struct {
+	value any
+	stack []byte
+}
+
+func (p *panicError) Error() string {
+	return fmt.Sprintf("singleflight: function panicked: %v\n\n%s", p.value, p.stack)
+}
+
+// Result holds the outcome of a call, as delivered by DoChan.
+type Result[V any] struct {
+	Value  V
+	Err    error
+	Shared bool
+}
+
+type call[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+	dups  int
+	chans []chan<- Result[V]
+}
+
+// Singleflight suppresses duplicate calls: concurrent calls for the same key share
+// the result of the first one instead of running the function again.
+// The zero value is ready to use.
+type Singleflight[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+// New creates an empty Singleflight.
+func New[K comparable, V any]() *Singleflight[K, V] {
+	return &Singleflight[K, V]{}
+}
+
+// Do calls fn for key unless a call for key is already running, in which case it
+// waits for that call and returns its result. shared reports whether the result
+// was given to more than one caller. If fn panics, every caller of Do sharing
+// the call panics with the same value; if fn calls runtime.Goexit, so do they.
+func (g *Singleflight[K, V]) Do(key K, fn func() (V, error)) (value V, err error, shared bool) {
+	g.mu.Lock()
+	if c, ok := g.calls[key]; ok {
+		c.dups++
+		g.mu.Unlock()
+		c.wg.Wait()
+		if e, ok := c.err.(*panicError); ok {
+			panic(e)
+		} else if c.err == errGoexit {
+			runtime.Goexit()
+		}
+		return c.value, c.err, true
+	}
+	c := g.start(key)
+	g.mu.Unlock()
+	g.run(c, key, fn)
+	return c.value, c.err, c.dups > 0
+}
+
+// DoChan is like Do but returns a channel that receives the result once it is ready.
+// fn runs in a new goroutine, so the caller may stop waiting without cancelling it.
+// Since no caller can recover it there, a panic in fn crashes the program.
+func (g *Singleflight[K, V]) DoChan(key K, fn func() (V, error)) <-chan Result[V] {
+	ch := make(chan Result[V], 1)
+	g.mu.Lock()
+	if c, ok := g.calls[key]; ok {
+		c.dups++
+		c.chans = append(c.chans, ch)
+		g.mu.Unlock()
+		return ch
+	}
+	c := g.start(key)
+	c.chans = append(c.chans, ch)
+	g.mu.Unlock()
+	go g.run(c, key, fn)
+	return ch
+}
+
+// start registers a new call for key. It must be called with g.mu held.
+func (g *Singleflight[K, V]) start(key K) *call[V] {
+	if g.calls == nil {
+		g.calls = make(map[K]*call[V])
+	}
+	c := &call[V]{}
+	c.wg.Add(1)
+	g.calls[key] = c
+	return c
+}
+
+// run calls fn and publishes its result. The cleanup is deferred so it also runs
+// when fn panics or calls runtime.Goexit; otherwise the key would stay blocked.
+func (g *Singleflight[K, V]) run(c *call[V], key K, fn func() (V, error)) {
+	normalReturn, recovered := false, false
+	defer func() {
+		if !normalReturn && !recovered {
+			c.err = errGoexit
+		}
+		g.mu.Lock()
+		if g.calls[key] == c {
+			delete(g.calls, key)
+		}
+		shared, chans := c.dups > 0, c.chans
+		g.mu.Unlock()
+		c.wg.Done()
+
+		if e, ok := c.err.(*panicError); ok {
+			if len(chans) > 0 {
+				// Crash rather than leave the DoChan callers waiting forever.
+				go panic(e)
+				select {}
+			}
+			panic(e)
+		}
+		for _, ch := range chans {
+			ch <- Result[V]{Value: c.value, Err: c.err, Shared: shared}
+		}
+	}()
+
+	func() {
+		defer func() {
+			if !normalReturn {
+				// recover returns nil for runtime.Goexit.
+				if r := recover(); r != nil {
+					c.err = &panicError{value: r, stack: debug.Stack()}
+				}
+			}
+		}()
+		c.value, c.err = fn()
+		normalReturn = true
+	}()
+	if !normalReturn {
+		recovered = true
+	}
+}
+
+// Forget makes the next call for key run its function even if a call is still running.
+func (g *Singleflight[K, V]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.calls, key)
+}
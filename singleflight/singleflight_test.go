@@ -0,0 +1,117 @@
+package singleflight
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// waiting returns the number of callers sharing the in-flight call for key.
+func (g *Singleflight[K, V]) waiting(key K) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if c, ok := g.calls[key]; ok {
+		return c.dups
+	}
+	return 0
+}
+
+func TestDo(t *testing.T) {
+	var (
+		g     Singleflight[string, int]
+		calls atomic.Int32
+		wg    sync.WaitGroup
+	)
+	release := make(chan struct{})
+	var sharedCount atomic.Int32
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err, shared := g.Do("key", func() (int, error) {
+				calls.Add(1)
+				<-release
+				return 42, nil
+			})
+			if err != nil || v != 42 {
+				t.Errorf("unexpected result: %d, %v", v, err)
+			}
+			if shared {
+				sharedCount.Add(1)
+			}
+		}()
+	}
+	// Release the call only once the other 7 callers have joined it.
+	for g.waiting("key") < 7 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected a single call, got %d", n)
+	}
+	if sharedCount.Load() != 8 {
+		t.Fatalf("expected every caller to see a shared result, got %d", sharedCount.Load())
+	}
+	if _, _, shared := g.Do("key", func() (int, error) { return 1, nil }); shared {
+		t.Fatal("expected a new call once the first one has returned")
+	}
+}
+
+func TestDoChan(t *testing.T) {
+	g := New[int, string]()
+	errFailed := errors.New("failed")
+	release := make(chan struct{})
+	a := g.DoChan(1, func() (string, error) {
+		<-release
+		return "", errFailed
+	})
+	b := g.DoChan(1, func() (string, error) { return "unused", nil })
+	close(release)
+	for _, ch := range []<-chan Result[string]{a, b} {
+		if r := <-ch; !errors.Is(r.Err, errFailed) || !r.Shared {
+			t.Fatalf("unexpected result: %+v", r)
+		}
+	}
+}
+
+func TestDoPanic(t *testing.T) {
+	var g Singleflight[string, int]
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("expected the panic to propagate")
+			}
+		}()
+		g.Do("key", func() (int, error) { panic("boom") })
+	}()
+	if v, err, _ := g.Do("key", func() (int, error) { return 1, nil }); err != nil || v != 1 {
+		t.Fatalf("expected the key to be usable after a panic, got %d, %v", v, err)
+	}
+}
+
+func TestDoGoexit(t *testing.T) {
+	var g Singleflight[string, int]
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		g.Do("key", func() (int, error) {
+			runtime.Goexit()
+			return 0, nil
+		})
+		t.Error("expected Do to exit the goroutine")
+	}()
+	<-done
+	if v, err, _ := g.Do("key", func() (int, error) { return 1, nil }); err != nil || v != 1 {
+		t.Fatalf("expected the key to be usable after Goexit, got %d, %v", v, err)
+	}
+	r := <-g.DoChan("chan", func() (int, error) {
+		runtime.Goexit()
+		return 0, nil
+	})
+	if !errors.Is(r.Err, errGoexit) {
+		t.Fatalf("expected errGoexit, got %v", r.Err)
+	}
+}
@@ -0,0 +1,57 @@
+package syncx
+
+import "sync/atomic"
+
+// Atomic is a typed value that can be loaded and stored atomically, for publishing
+// snapshots such as configuration to hot read paths without type assertions.
+// The zero value holds the zero value of T.
+type Atomic[T any] struct {
+	v atomic.Pointer[T]
+}
+
+// NewAtomic creates an Atomic holding value.
+func NewAtomic[T any](value T) *Atomic[T] {
+	a := &Atomic[T]{}
+	a.Store(value)
+	return a
+}
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() T {
+	if p := a.v.Load(); p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
+
+// Store sets the value.
+func (a *Atomic[T]) Store(value T) {
+	a.v.Store(&value)
+}
+
+// Swap sets the value and returns the previous one.
+func (a *Atomic[T]) Swap(value T) (old T) {
+	if p := a.v.Swap(&value); p != nil {
+		return *p
+	}
+	return old
+}
+
+// CompareAndSwap sets the value to new if the current value equals old, and reports
+// whether it did. Like atomic.Value, it panics if the values are not comparable.
+func (a *Atomic[T]) CompareAndSwap(old, new T) bool {
+	for {
+		p := a.v.Load()
+		var current T
+		if p != nil {
+			current = *p
+		}
+		if any(current) != any(old) {
+			return false
+		}
+		if a.v.CompareAndSwap(p, &new) {
+			return true
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package syncx
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomic(t *testing.T) {
+	var a Atomic[string]
+	if v := a.Load(); v != "" {
+		t.Fatalf("expected zero value, got %q", v)
+	}
+	if !a.CompareAndSwap("", "a") {
+		t.Fatal("expected swap from the zero value to succeed")
+	}
+	if a.CompareAndSwap("b", "c") {
+		t.Fatal("expected swap with a stale old value to fail")
+	}
+	if old := a.Swap("b"); old != "a" || a.Load() != "b" {
+		t.Fatalf("unexpected swap result: %q, %q", old, a.Load())
+	}
+
+	n := NewAtomic(0)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				for {
+					v := n.Load()
+					if n.CompareAndSwap(v, v+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if v := n.Load(); v != 800 {
+		t.Fatalf("expected 800, got %d", v)
+	}
+}
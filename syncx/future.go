@@ -0,0 +1,148 @@
+package syncx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-kratos/kit/result"
+)
+
+// Future is the eventual result of an asynchronous computation.
+type Future[T any] struct {
+	done chan struct{}
+	res  result.Result[T]
+}
+
+// Promise completes a Future from the producer side.
+type Promise[T any] struct {
+	once   sync.Once
+	future *Future[T]
+}
+
+// NewPromise creates a Promise and its pending Future.
+func NewPromise[T any]() *Promise[T] {
+	return &Promise[T]{future: &Future[T]{done: make(chan struct{})}}
+}
+
+// Future returns the Future completed by the Promise.
+func (p *Promise[T]) Future() *Future[T] {
+	return p.future
+}
+
+// Complete completes the Future with value and err. Only the first call has an
+// effect; it reports whether this call completed the Future.
+func (p *Promise[T]) Complete(value T, err error) bool {
+	completed := false
+	p.once.Do(func() {
+		p.future.res = result.Of(value, err)
+		close(p.future.done)
+		completed = true
+	})
+	return completed
+}
+
+// Go runs f in a new goroutine and returns a Future for its result.
+// A panic in f completes the Future with an error instead of crashing the program.
+func Go[T any](f func() (T, error)) *Future[T] {
+	p := NewPromise[T]()
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				var zero T
+				p.Complete(zero, fmt.Errorf("syncx: future panicked: %v", r))
+			}
+		}()
+		p.Complete(f())
+	}()
+	return p.future
+}
+
+// Done returns a channel that is closed when the Future completes.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await waits for the Future to complete and returns its result.
+// It returns ctx.Err() if ctx is done first.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.res.Unwrap()
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Result waits for the Future to complete and returns its result as a Result,
+// or a failed Result with ctx.Err() if ctx is done first.
+func (f *Future[T]) Result(ctx context.Context) result.Result[T] {
+	return result.Of(f.Await(ctx))
+}
+
+// Then returns a Future that applies fn to the value of f once it completes
+// successfully. An error from f is passed through without calling fn.
+func Then[T, U any](f *Future[T], fn func(T) (U, error)) *Future[U] {
+	p := NewPromise[U]()
+	go func() {
+		<-f.done
+		value, err := f.res.Unwrap()
+		if err != nil {
+			var zero U
+			p.Complete(zero, err)
+			return
+		}
+		p.Complete(fn(value))
+	}()
+	return p.future
+}
+
+// AwaitAll waits for every future and returns their values in order.
+// It returns the first error in order, or ctx.Err() if ctx is done first.
+func AwaitAll[T any](ctx context.Context, futures ...*Future[T]) ([]T, error) {
+	values := make([]T, len(futures))
+	for i, f := range futures {
+		value, err := f.Await(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// AwaitAny returns the value of the first future to complete successfully.
+// If every future fails it returns all their errors joined, and it returns
+// ctx.Err() if ctx is done first.
+func AwaitAny[T any](ctx context.Context, futures ...*Future[T]) (T, error) {
+	var zero T
+	if len(futures) == 0 {
+		return zero, errors.New("syncx: AwaitAny called with no futures")
+	}
+	type completion struct {
+		index int
+		res   result.Result[T]
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	completions := make(chan completion, len(futures))
+	for i, f := range futures {
+		go func() {
+			completions <- completion{i, f.Result(ctx)}
+		}()
+	}
+	errs := make([]error, len(futures))
+	for range futures {
+		c := <-completions
+		if c.res.IsOk() {
+			return c.res.Unwrap()
+		}
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		errs[c.index] = c.res.Err()
+	}
+	return zero, errors.Join(errs...)
+}
@@ -0,0 +1,60 @@
+package syncx
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestFuture(t *testing.T) {
+	ctx := context.Background()
+	f := Go(func() (int, error) { return 21, nil })
+	s := Then(f, func(v int) (string, error) { return strconv.Itoa(v * 2), nil })
+	if v, err := s.Await(ctx); err != nil || v != "42" {
+		t.Fatalf("unexpected result: %q, %v", v, err)
+	}
+
+	panicked := Go(func() (int, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Fatalf("expected a panic to become an error")
+	}
+
+	slow := Go(func() (int, error) {
+		time.Sleep(time.Second)
+		return 0, nil
+	})
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := slow.Await(timeout); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected Await to time out, got %v", err)
+	}
+}
+
+func TestAwaitAllAndAny(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+	futures := []*Future[int]{
+		Go(func() (int, error) { return 1, nil }),
+		Go(func() (int, error) { return 2, nil }),
+	}
+	if values, err := AwaitAll(ctx, futures...); err != nil || len(values) != 2 || values[1] != 2 {
+		t.Fatalf("unexpected AwaitAll result: %v, %v", values, err)
+	}
+	failing := Go(func() (int, error) { return 0, errBoom })
+	if _, err := AwaitAll(ctx, futures[0], failing); !errors.Is(err, errBoom) {
+		t.Fatalf("expected AwaitAll to return the error, got %v", err)
+	}
+
+	p := NewPromise[int]()
+	if v, err := AwaitAny(ctx, failing, p.Future(), futures[1]); err != nil || v != 2 {
+		t.Fatalf("expected the first success, got %d, %v", v, err)
+	}
+	if _, err := AwaitAny(ctx, failing, failing); !errors.Is(err, errBoom) {
+		t.Fatalf("expected joined errors, got %v", err)
+	}
+	if !p.Complete(3, nil) || p.Complete(4, nil) {
+		t.Fatalf("expected only the first Complete to take effect")
+	}
+}
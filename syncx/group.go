@@ -0,0 +1,72 @@
+package syncx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Group runs tasks in goroutines and collects their results in submission order.
+// The zero value runs every task at once.
+type Group[T any] struct {
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	results []T
+	errs    []error
+	sem     *Semaphore
+}
+
+// NewGroup creates a Group that runs at most limit tasks at a time.
+// A limit less than 1 means no limit.
+func NewGroup[T any](limit int) *Group[T] {
+	g := &Group[T]{}
+	if limit > 0 {
+		g.sem = NewSemaphore(int64(limit))
+	}
+	return g
+}
+
+// Go runs f in a new goroutine, blocking while the concurrency limit is reached.
+// A panic in f is reported as its error instead of crashing the program.
+func (g *Group[T]) Go(f func() (T, error)) {
+	g.mu.Lock()
+	i := len(g.results)
+	g.results = append(g.results, *new(T))
+	g.errs = append(g.errs, nil)
+	g.mu.Unlock()
+
+	if g.sem != nil {
+		_ = g.sem.Acquire(context.Background(), 1)
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer g.sem.Release(1)
+		}
+		value, err := g.run(f)
+		g.mu.Lock()
+		g.results[i], g.errs[i] = value, err
+		g.mu.Unlock()
+	}()
+}
+
+func (g *Group[T]) run(f func() (T, error)) (value T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("syncx: group task panicked: %v", r)
+		}
+	}()
+	return f()
+}
+
+// Wait blocks until every task has returned. It returns their results in the order
+// the tasks were submitted, with the zero value for tasks that failed, and the
+// errors of the failed tasks joined in the same order.
+func (g *Group[T]) Wait() ([]T, error) {
+	g.wg.Wait()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.results, errors.Join(g.errs...)
+}
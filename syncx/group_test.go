@@ -0,0 +1,52 @@
+package syncx
+
+import (
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroup(t *testing.T) {
+	g := NewGroup[int](2)
+	var running, peak atomic.Int32
+	errOdd := errors.New("odd")
+	for i := range 6 {
+		g.Go(func() (int, error) {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Duration(6-i) * time.Millisecond)
+			running.Add(-1)
+			if i == 3 {
+				return 0, errOdd
+			}
+			return i * i, nil
+		})
+	}
+	results, err := g.Wait()
+	if !slices.Equal(results, []int{0, 1, 4, 0, 16, 25}) {
+		t.Fatalf("expected results in submission order, got %v", results)
+	}
+	if !errors.Is(err, errOdd) {
+		t.Fatalf("expected the task error, got %v", err)
+	}
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("expected at most 2 concurrent tasks, got %d", p)
+	}
+}
+
+func TestGroupPanic(t *testing.T) {
+	var g Group[string]
+	g.Go(func() (string, error) { panic("boom") })
+	g.Go(func() (string, error) { return "ok", nil })
+	results, err := g.Wait()
+	if err == nil || results[1] != "ok" {
+		t.Fatalf("expected the panic to be reported, got %v, %v", results, err)
+	}
+}
@@ -0,0 +1,65 @@
+package syncx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-kratos/kit/containers/maps"
+)
+
+// KeyedGroup runs tasks in goroutines, one per key, and collects their results in a Map.
+// The first failing task cancels the context given to the others.
+type KeyedGroup[K comparable, V any] struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	results *maps.Map[K, V]
+	mu      sync.Mutex
+	errs    []error
+}
+
+// NewKeyedGroup creates a KeyedGroup whose tasks run with a context derived from ctx.
+func NewKeyedGroup[K comparable, V any](ctx context.Context) *KeyedGroup[K, V] {
+	ctx, cancel := context.WithCancel(ctx)
+	return &KeyedGroup[K, V]{ctx: ctx, cancel: cancel, results: maps.New[K, V]()}
+}
+
+// Go runs f for key in a new goroutine. If f succeeds its value is stored under key.
+// A panic in f is reported as its error instead of crashing the program.
+func (g *KeyedGroup[K, V]) Go(key K, f func(ctx context.Context) (V, error)) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		value, err := g.run(f)
+		if err != nil {
+			g.mu.Lock()
+			g.errs = append(g.errs, fmt.Errorf("key %v: %w", key, err))
+			g.mu.Unlock()
+			g.cancel()
+			return
+		}
+		g.results.Store(key, value)
+	}()
+}
+
+func (g *KeyedGroup[K, V]) run(f func(ctx context.Context) (V, error)) (value V, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("syncx: group task panicked: %v", r)
+		}
+	}()
+	return f(g.ctx)
+}
+
+// Wait blocks until every task has returned, then cancels the group's context.
+// It returns the values of the tasks that succeeded and the errors of those that
+// failed, joined in the order they failed.
+func (g *KeyedGroup[K, V]) Wait() (*maps.Map[K, V], error) {
+	g.wg.Wait()
+	g.cancel()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.results, errors.Join(g.errs...)
+}
@@ -0,0 +1,46 @@
+package syncx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKeyedGroup(t *testing.T) {
+	g := NewKeyedGroup[string, int](context.Background())
+	for _, key := range []string{"a", "bb", "ccc"} {
+		g.Go(key, func(context.Context) (int, error) { return len(key), nil })
+	}
+	results, err := g.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.ToMap()) != 3 {
+		t.Fatalf("expected 3 results, got %v", results.ToMap())
+	}
+	if v, ok := results.Load("ccc"); !ok || v != 3 {
+		t.Fatalf("expected 3 for ccc, got %d", v)
+	}
+}
+
+func TestKeyedGroupCancelsOnFailure(t *testing.T) {
+	g := NewKeyedGroup[int, string](context.Background())
+	errFailed := errors.New("failed")
+	g.Go(1, func(context.Context) (string, error) { return "", errFailed })
+	g.Go(2, func(ctx context.Context) (string, error) {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(time.Second):
+			return "slow", nil
+		}
+	})
+	results, err := g.Wait()
+	if !errors.Is(err, errFailed) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the failure and the cancellation, got %v", err)
+	}
+	if len(results.ToMap()) != 0 {
+		t.Fatalf("expected no results, got %v", results.ToMap())
+	}
+}
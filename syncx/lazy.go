@@ -0,0 +1,76 @@
+package syncx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy is a value computed on first use. It is safe for concurrent use:
+// concurrent first calls to Get wait for a single computation.
+type Lazy[T any] struct {
+	mu    sync.Mutex
+	value atomic.Pointer[T]
+	f     func() T
+}
+
+// NewLazy creates a Lazy whose value is computed by f.
+func NewLazy[T any](f func() T) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+// Get returns the value, computing it on the first call.
+func (l *Lazy[T]) Get() T {
+	if p := l.value.Load(); p != nil {
+		return *p
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if p := l.value.Load(); p != nil {
+		return *p
+	}
+	value := l.f()
+	l.value.Store(&value)
+	return value
+}
+
+// Reset discards the value, so the next Get computes it again.
+func (l *Lazy[T]) Reset() {
+	l.value.Store(nil)
+}
+
+// LazyErr is a value computed on first use by a function that can fail.
+// Unlike sync.OnceValues, a failed computation is not cached: the next Get tries again.
+type LazyErr[T any] struct {
+	mu    sync.Mutex
+	value atomic.Pointer[T]
+	f     func() (T, error)
+}
+
+// NewLazyErr creates a LazyErr whose value is computed by f.
+func NewLazyErr[T any](f func() (T, error)) *LazyErr[T] {
+	return &LazyErr[T]{f: f}
+}
+
+// Get returns the value, computing it if no earlier call succeeded.
+func (l *LazyErr[T]) Get() (T, error) {
+	if p := l.value.Load(); p != nil {
+		return *p, nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if p := l.value.Load(); p != nil {
+		return *p, nil
+	}
+	value, err := l.f()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	l.value.Store(&value)
+	return value, nil
+}
+
+// Reset discards the value, so the next Get computes it again.
+func (l *LazyErr[T]) Reset() {
+	l.value.Store(nil)
+}
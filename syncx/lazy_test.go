@@ -0,0 +1,53 @@
+package syncx
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() int {
+		return int(calls.Add(1))
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := l.Get(); v != 1 {
+				t.Errorf("expected 1, got %d", v)
+			}
+		}()
+	}
+	wg.Wait()
+	l.Reset()
+	if v := l.Get(); v != 2 {
+		t.Fatalf("expected Reset to recompute, got %d", v)
+	}
+}
+
+func TestLazyErrRetriesFailures(t *testing.T) {
+	errBoom := errors.New("boom")
+	attempts := 0
+	l := NewLazyErr(func() (string, error) {
+		attempts++
+		if attempts == 1 {
+			return "", errBoom
+		}
+		return "ready", nil
+	})
+	if _, err := l.Get(); !errors.Is(err, errBoom) {
+		t.Fatalf("expected first Get to fail, got %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if v, err := l.Get(); err != nil || v != "ready" {
+			t.Fatalf("unexpected Get result: %q, %v", v, err)
+		}
+	}
+	if attempts != 2 {
+		t.Fatalf("expected the value to be computed twice, got %d", attempts)
+	}
+}
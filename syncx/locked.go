@@ -0,0 +1,65 @@
+package syncx
+
+import "sync"
+
+// Locked holds a value guarded by a mutex. The value is only reachable through
+// With, so it cannot be touched without holding the lock.
+type Locked[T any] struct {
+	mu    sync.Mutex
+	value T
+}
+
+// NewLocked creates a Locked holding value.
+func NewLocked[T any](value T) *Locked[T] {
+	return &Locked[T]{value: value}
+}
+
+// With calls f with a pointer to the value while holding the lock.
+// f must not keep the pointer after it returns.
+func (l *Locked[T]) With(f func(value *T)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f(&l.value)
+}
+
+// Load returns a copy of the value.
+func (l *Locked[T]) Load() T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.value
+}
+
+// RWLocked holds a value guarded by a read-write mutex. Writers use With and
+// readers use RWith, which may run concurrently with other readers.
+type RWLocked[T any] struct {
+	mu    sync.RWMutex
+	value T
+}
+
+// NewRWLocked creates an RWLocked holding value.
+func NewRWLocked[T any](value T) *RWLocked[T] {
+	return &RWLocked[T]{value: value}
+}
+
+// With calls f with a pointer to the value while holding the write lock.
+// f must not keep the pointer after it returns.
+func (l *RWLocked[T]) With(f func(value *T)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f(&l.value)
+}
+
+// RWith calls f with the value while holding the read lock.
+// f must not modify data the value shares, such as the contents of a map or slice.
+func (l *RWLocked[T]) RWith(f func(value T)) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	f(l.value)
+}
+
+// Load returns a copy of the value.
+func (l *RWLocked[T]) Load() T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.value
+}
@@ -0,0 +1,32 @@
+package syncx
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLocked(t *testing.T) {
+	l := NewLocked(map[string]int{})
+	rw := NewRWLocked(0)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				l.With(func(m *map[string]int) { (*m)["n"]++ })
+				rw.With(func(n *int) { *n++ })
+				rw.RWith(func(n int) { _ = n })
+			}
+		}()
+	}
+	wg.Wait()
+	if n := l.Load()["n"]; n != 800 {
+		t.Fatalf("expected 800, got %d", n)
+	}
+	var got int
+	rw.RWith(func(n int) { got = n })
+	if got != 800 || rw.Load() != 800 {
+		t.Fatalf("expected 800, got %d", got)
+	}
+}
@@ -0,0 +1,163 @@
+package syncx
+
+import (
+	"math"
+	"sync/atomic"
+
+	"github.com/go-kratos/kit/constraints"
+)
+
+// AtomicInt is an integer of type N that is updated atomically.
+// Arithmetic wraps around like it does on N. The zero value holds 0.
+type AtomicInt[N constraints.Integer] struct {
+	// v holds the bits of the value sign-extended to 64 bits. Additions may carry
+	// past the width of N, so it is always converted back to N before comparing.
+	v atomic.Uint64
+}
+
+// NewAtomicInt creates an AtomicInt holding value.
+func NewAtomicInt[N constraints.Integer](value N) *AtomicInt[N] {
+	a := &AtomicInt[N]{}
+	a.Store(value)
+	return a
+}
+
+// Load returns the current value.
+func (a *AtomicInt[N]) Load() N {
+	return N(a.v.Load())
+}
+
+// Store sets the value.
+func (a *AtomicInt[N]) Store(value N) {
+	a.v.Store(uint64(value))
+}
+
+// Swap sets the value and returns the previous one.
+func (a *AtomicInt[N]) Swap(value N) N {
+	return N(a.v.Swap(uint64(value)))
+}
+
+// Add adds delta to the value and returns the new value.
+func (a *AtomicInt[N]) Add(delta N) N {
+	return N(a.v.Add(uint64(delta)))
+}
+
+// CompareAndSwap sets the value to new if it equals old, and reports whether it did.
+func (a *AtomicInt[N]) CompareAndSwap(old, new N) bool {
+	return a.update(func(current N) (N, bool) {
+		return new, current == old
+	})
+}
+
+// Max sets the value to the larger of itself and value, and returns the result.
+func (a *AtomicInt[N]) Max(value N) N {
+	var result N
+	a.update(func(current N) (N, bool) {
+		result = max(current, value)
+		return result, result != current
+	})
+	return result
+}
+
+// Min sets the value to the smaller of itself and value, and returns the result.
+func (a *AtomicInt[N]) Min(value N) N {
+	var result N
+	a.update(func(current N) (N, bool) {
+		result = min(current, value)
+		return result, result != current
+	})
+	return result
+}
+
+// update replaces the value with next(current) until it succeeds, unless next
+// reports there is nothing to store. It reports whether a value was stored.
+func (a *AtomicInt[N]) update(next func(current N) (N, bool)) bool {
+	for {
+		raw := a.v.Load()
+		value, ok := next(N(raw))
+		if !ok {
+			return false
+		}
+		if a.v.CompareAndSwap(raw, uint64(value)) {
+			return true
+		}
+	}
+}
+
+// AtomicFloat64 is a float64 that is updated atomically. The zero value holds 0.
+type AtomicFloat64 struct {
+	v atomic.Uint64
+}
+
+// NewAtomicFloat64 creates an AtomicFloat64 holding value.
+func NewAtomicFloat64(value float64) *AtomicFloat64 {
+	a := &AtomicFloat64{}
+	a.Store(value)
+	return a
+}
+
+// Load returns the current value.
+func (a *AtomicFloat64) Load() float64 {
+	return math.Float64frombits(a.v.Load())
+}
+
+// Store sets the value.
+func (a *AtomicFloat64) Store(value float64) {
+	a.v.Store(math.Float64bits(value))
+}
+
+// Swap sets the value and returns the previous one.
+func (a *AtomicFloat64) Swap(value float64) float64 {
+	return math.Float64frombits(a.v.Swap(math.Float64bits(value)))
+}
+
+// Add adds delta to the value and returns the new value.
+func (a *AtomicFloat64) Add(delta float64) float64 {
+	var result float64
+	a.update(func(current float64) (float64, bool) {
+		result = current + delta
+		return result, true
+	})
+	return result
+}
+
+// CompareAndSwap sets the value to new if it equals old, and reports whether it did.
+// As with ==, a NaN never equals old.
+func (a *AtomicFloat64) CompareAndSwap(old, new float64) bool {
+	return a.update(func(current float64) (float64, bool) {
+		return new, current == old
+	})
+}
+
+// Max sets the value to the larger of itself and value, and returns the result.
+func (a *AtomicFloat64) Max(value float64) float64 {
+	var result float64
+	a.update(func(current float64) (float64, bool) {
+		result = max(current, value)
+		return result, math.Float64bits(result) != math.Float64bits(current)
+	})
+	return result
+}
+
+// Min sets the value to the smaller of itself and value, and returns the result.
+func (a *AtomicFloat64) Min(value float64) float64 {
+	var result float64
+	a.update(func(current float64) (float64, bool) {
+		result = min(current, value)
+		return result, math.Float64bits(result) != math.Float64bits(current)
+	})
+	return result
+}
+
+func (a *AtomicFloat64) update(next func(current float64) (float64, bool)) bool {
+	for {
+		raw := a.v.Load()
+		value, ok := next(math.Float64frombits(raw))
+		if !ok {
+			return false
+		}
+		if a.v.CompareAndSwap(raw, math.Float64bits(value)) {
+			return true
+		}
+	}
+}
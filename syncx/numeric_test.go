@@ -0,0 +1,68 @@
+package syncx
+
+import (
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestAtomicInt(t *testing.T) {
+	var a AtomicInt[int8]
+	a.Store(math.MaxInt8)
+	if v := a.Add(1); v != math.MinInt8 {
+		t.Fatalf("expected wrap-around to %d, got %d", math.MinInt8, v)
+	}
+	if !a.CompareAndSwap(math.MinInt8, 5) {
+		t.Fatal("expected swap after wrap-around to succeed")
+	}
+	if v := a.Max(3); v != 5 {
+		t.Fatalf("expected max to keep 5, got %d", v)
+	}
+	if v := a.Min(-2); v != -2 || a.Load() != -2 {
+		t.Fatalf("expected min to store -2, got %d", v)
+	}
+
+	n := NewAtomicInt[uint32](0)
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				n.Add(1)
+				n.Max(uint32(i*100 + j))
+			}
+		}()
+	}
+	wg.Wait()
+	if v := n.Load(); v < 800 {
+		t.Fatalf("expected at least 800, got %d", v)
+	}
+}
+
+func TestAtomicFloat64(t *testing.T) {
+	a := NewAtomicFloat64(1.5)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				a.Add(0.5)
+			}
+		}()
+	}
+	wg.Wait()
+	if v := a.Load(); v != 401.5 {
+		t.Fatalf("expected 401.5, got %v", v)
+	}
+	if v := a.Max(-1); v != 401.5 {
+		t.Fatalf("expected max to keep 401.5, got %v", v)
+	}
+	if v := a.Min(-1); v != -1 {
+		t.Fatalf("expected min to store -1, got %v", v)
+	}
+	if a.CompareAndSwap(0, 1) || !a.CompareAndSwap(-1, 2) || a.Swap(3) != 2 {
+		t.Fatal("unexpected compare-and-swap result")
+	}
+}
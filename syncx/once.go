@@ -0,0 +1,49 @@
+package syncx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Once runs a function that returns a value and an error at most once and caches
+// its result. Unlike LazyErr, the function is given to Do, so one Once can guard
+// building a shared client wherever it is first needed. The zero value caches
+// errors like sync.OnceValues.
+type Once[T any] struct {
+	mu           sync.Mutex
+	done         atomic.Bool
+	value        T
+	err          error
+	retryOnError bool
+}
+
+// NewOnce creates a Once. If retryOnError is true, a call that fails is not cached
+// and the next Do runs its function again.
+func NewOnce[T any](retryOnError bool) *Once[T] {
+	return &Once[T]{retryOnError: retryOnError}
+}
+
+// Do calls f if no earlier call completed and returns the cached result.
+// Concurrent calls wait for the one running f. If f panics, nothing is cached.
+func (o *Once[T]) Do(f func() (T, error)) (T, error) {
+	if o.done.Load() {
+		return o.value, o.err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.done.Load() {
+		return o.value, o.err
+	}
+	value, err := f()
+	if err != nil && o.retryOnError {
+		return value, err
+	}
+	o.value, o.err = value, err
+	o.done.Store(true)
+	return value, err
+}
+
+// Done reports whether a result has been cached.
+func (o *Once[T]) Done() bool {
+	return o.done.Load()
+}
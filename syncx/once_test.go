@@ -0,0 +1,46 @@
+package syncx
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOnce(t *testing.T) {
+	var (
+		o     Once[int]
+		calls atomic.Int32
+		wg    sync.WaitGroup
+	)
+	errFailed := errors.New("failed")
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := o.Do(func() (int, error) {
+				calls.Add(1)
+				return 0, errFailed
+			}); !errors.Is(err, errFailed) {
+				t.Errorf("expected cached error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := calls.Load(); n != 1 || !o.Done() {
+		t.Fatalf("expected a single cached call, got %d", n)
+	}
+}
+
+func TestOnceRetryOnError(t *testing.T) {
+	o := NewOnce[string](true)
+	if _, err := o.Do(func() (string, error) { return "", errors.New("failed") }); err == nil || o.Done() {
+		t.Fatal("expected the failure not to be cached")
+	}
+	if v, err := o.Do(func() (string, error) { return "ok", nil }); err != nil || v != "ok" {
+		t.Fatalf("expected retry to succeed, got %q, %v", v, err)
+	}
+	if v, _ := o.Do(func() (string, error) { return "again", nil }); v != "ok" {
+		t.Fatalf("expected the cached value, got %q", v)
+	}
+}
@@ -0,0 +1,29 @@
+package syncx
+
+import "sync"
+
+// Pool is a typed sync.Pool. T should be a pointer type: like sync.Pool,
+// putting a non-pointer value allocates.
+type Pool[T any] struct {
+	p     sync.Pool
+	reset func(T)
+}
+
+// NewPool creates a Pool that calls new when it has nothing to reuse.
+// If reset is not nil, it is called on every value passed to Put.
+func NewPool[T any](new func() T, reset func(T)) *Pool[T] {
+	return &Pool[T]{p: sync.Pool{New: func() any { return new() }}, reset: reset}
+}
+
+// Get returns a value from the pool, creating one if none is available.
+func (p *Pool[T]) Get() T {
+	return p.p.Get().(T)
+}
+
+// Put resets value and returns it to the pool.
+func (p *Pool[T]) Put(value T) {
+	if p.reset != nil {
+		p.reset(value)
+	}
+	p.p.Put(value)
+}
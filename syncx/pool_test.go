@@ -0,0 +1,27 @@
+package syncx
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPool(t *testing.T) {
+	created := 0
+	p := NewPool(func() *bytes.Buffer {
+		created++
+		return new(bytes.Buffer)
+	}, (*bytes.Buffer).Reset)
+
+	b := p.Get()
+	if created != 1 {
+		t.Fatalf("expected New to be called once, got %d", created)
+	}
+	b.WriteString("dirty")
+	p.Put(b)
+	if b.Len() != 0 {
+		t.Fatal("expected Put to reset the value")
+	}
+	if got := p.Get(); got.Len() != 0 {
+		t.Fatal("expected a clean buffer from Get")
+	}
+}
@@ -0,0 +1,109 @@
+package syncx
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kit/containers/lists"
+)
+
+type waiter struct {
+	n     int64
+	ready chan struct{}
+}
+
+// Semaphore is a weighted semaphore bounding how much of a resource is in use.
+// Waiters are served in FIFO order, so a large request is not starved by small ones.
+type Semaphore struct {
+	mu      sync.Mutex
+	size    int64
+	cur     int64
+	waiters *lists.List[waiter]
+}
+
+// NewSemaphore creates a Semaphore with a total weight of size.
+func NewSemaphore(size int64) *Semaphore {
+	return &Semaphore{size: size, waiters: lists.New[waiter]()}
+}
+
+// Acquire acquires a weight of n, blocking until it is available or ctx is done.
+// On failure it returns ctx.Err() and leaves the semaphore unchanged.
+func (s *Semaphore) Acquire(ctx context.Context, n int64) error {
+	done := ctx.Done()
+	s.mu.Lock()
+	select {
+	case <-done:
+		s.mu.Unlock()
+		return ctx.Err()
+	default:
+	}
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		s.mu.Unlock()
+		return nil
+	}
+	if n > s.size {
+		// The request can never be satisfied.
+		s.mu.Unlock()
+		<-done
+		return ctx.Err()
+	}
+	ready := make(chan struct{})
+	e := s.waiters.PushBack(waiter{n: n, ready: ready})
+	s.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-done:
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-ready:
+		// Acquired after ctx was done; keep it rather than undoing the grant.
+		return nil
+	default:
+	}
+	isFront := s.waiters.Front() == e
+	s.waiters.Remove(e)
+	if isFront && s.size > s.cur {
+		// The removed waiter may have been blocking smaller ones behind it.
+		s.notifyWaiters()
+	}
+	return ctx.Err()
+}
+
+// TryAcquire acquires a weight of n without blocking and reports whether it did.
+func (s *Semaphore) TryAcquire(n int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		return true
+	}
+	return false
+}
+
+// Release releases a weight of n. It panics if more is released than is held.
+func (s *Semaphore) Release(n int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cur -= n
+	if s.cur < 0 {
+		panic("syncx: semaphore released more than held")
+	}
+	s.notifyWaiters()
+}
+
+func (s *Semaphore) notifyWaiters() {
+	for e := s.waiters.Front(); e != nil; e = s.waiters.Front() {
+		w := e.Value
+		if s.size-s.cur < w.n {
+			return
+		}
+		s.cur += w.n
+		s.waiters.Remove(e)
+		close(w.ready)
+	}
+}
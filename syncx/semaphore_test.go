@@ -0,0 +1,73 @@
+package syncx
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSemaphore(t *testing.T) {
+	s := NewSemaphore(3)
+	if !s.TryAcquire(2) || s.TryAcquire(2) {
+		t.Fatal("unexpected TryAcquire result")
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		if err := s.Acquire(context.Background(), 3); err != nil {
+			t.Error(err)
+		}
+		close(acquired)
+	}()
+	for queued := false; !queued; {
+		runtime.Gosched()
+		s.mu.Lock()
+		queued = s.waiters.Len() == 1
+		s.mu.Unlock()
+	}
+	if s.TryAcquire(1) {
+		t.Fatal("expected TryAcquire not to overtake a queued waiter")
+	}
+	s.Release(2)
+	<-acquired
+	s.Release(3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := s.Acquire(ctx, 4); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if !s.TryAcquire(3) {
+		t.Fatal("expected a failed Acquire to leave the semaphore unchanged")
+	}
+}
+
+func TestSemaphoreBoundsConcurrency(t *testing.T) {
+	s := NewSemaphore(2)
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.Acquire(context.Background(), 1); err != nil {
+				t.Error(err)
+				return
+			}
+			defer s.Release(1)
+			n := running.Add(1)
+			for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+	wg.Wait()
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("expected at most 2 holders, got %d", p)
+	}
+}
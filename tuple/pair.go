@@ -0,0 +1,63 @@
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair creates a Pair from two values.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Unpack returns the values of the pair.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// String returns the pair formatted like (a, b).
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// MarshalJSON encodes the pair as a two-element JSON array.
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{p.First, p.Second})
+}
+
+// UnmarshalJSON decodes a two-element JSON array into the pair.
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return fmt.Errorf("tuple: expected 2 elements for Pair, got %d", len(raw))
+	}
+	return unmarshalAll(raw, &p.First, &p.Second)
+}
+
+// Equal reports whether two pairs of comparable values are equal.
+func Equal[A, B comparable](x, y Pair[A, B]) bool {
+	return x == y
+}
+
+// EqualFunc reports whether two pairs are equal, comparing their values with eqA and eqB.
+func EqualFunc[A, B any](x, y Pair[A, B], eqA func(a, b A) bool, eqB func(a, b B) bool) bool {
+	return eqA(x.First, y.First) && eqB(x.Second, y.Second)
+}
+
+func unmarshalAll(raw []json.RawMessage, values ...any) error {
+	for i, v := range values {
+		if err := json.Unmarshal(raw[i], v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Triple holds three values of possibly different types.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple creates a Triple from three values.
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// Unpack returns the values of the triple.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// String returns the triple formatted like (a, b, c).
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
+
+// MarshalJSON encodes the triple as a three-element JSON array.
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+// UnmarshalJSON decodes a three-element JSON array into the triple.
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("tuple: expected 3 elements for Triple, got %d", len(raw))
+	}
+	return unmarshalAll(raw, &t.First, &t.Second, &t.Third)
+}
+
+// EqualTriple reports whether two triples of comparable values are equal.
+func EqualTriple[A, B, C comparable](x, y Triple[A, B, C]) bool {
+	return x == y
+}
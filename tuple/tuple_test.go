@@ -0,0 +1,46 @@
+package tuple
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestPair(t *testing.T) {
+	p := NewPair("a", 1)
+	if first, second := p.Unpack(); first != "a" || second != 1 {
+		t.Fatalf("unexpected unpacked values: %v, %v", first, second)
+	}
+	if p.String() != "(a, 1)" {
+		t.Fatalf("unexpected String: %s", p)
+	}
+	data, err := json.Marshal(p)
+	if err != nil || string(data) != `["a",1]` {
+		t.Fatalf("unexpected JSON: %s, %v", data, err)
+	}
+	var q Pair[string, int]
+	if err := json.Unmarshal(data, &q); err != nil || !Equal(p, q) {
+		t.Fatalf("unexpected round trip: %v, %v", q, err)
+	}
+	if err := json.Unmarshal([]byte(`["a"]`), &q); err == nil {
+		t.Fatalf("expected an error for a short array")
+	}
+
+	x := NewPair([]int{1}, "b")
+	y := NewPair([]int{1}, "b")
+	if !EqualFunc(x, y, slices.Equal[[]int], func(a, b string) bool { return a == b }) {
+		t.Fatalf("expected EqualFunc to compare slices by content")
+	}
+}
+
+func TestTriple(t *testing.T) {
+	tr := NewTriple(1, "b", true)
+	data, err := json.Marshal(tr)
+	if err != nil || string(data) != `[1,"b",true]` {
+		t.Fatalf("unexpected JSON: %s, %v", data, err)
+	}
+	var got Triple[int, string, bool]
+	if err := json.Unmarshal(data, &got); err != nil || !EqualTriple(tr, got) {
+		t.Fatalf("unexpected round trip: %v, %v", got, err)
+	}
+}